@@ -0,0 +1,237 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregator rolls up flow records into time windows.
+package aggregator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+const (
+	pluginName  string = "aggregator"
+	channelName string = "flattenerchan"
+)
+
+// aggKey identifies an aggregate by the hash of its key fields and the start of its window.
+type aggKey struct {
+	h     uint64
+	start int64
+}
+
+// aggregate holds the roll-up of the flows of a key in a window. The record is a copy of the first
+// flow, whose counters, timestamps and operation flags are updated with the flows that follow.
+type aggregate struct {
+	fr      *sfgo.FlatRecord
+	start   int64
+	seq     uint64
+	updated time.Time
+}
+
+// Aggregator defines a processor that rolls up network and file flows per key into tumbling
+// windows of record time. Other records are forwarded as they are.
+type Aggregator struct {
+	config    Config
+	keys      map[string][]engine.StrFieldMap
+	aggs      map[aggKey]*aggregate
+	seq       uint64
+	watermark int64
+	outCh     []chan *sfgo.FlatRecord
+}
+
+// NewAggregator creates a new Aggregator instance.
+func NewAggregator() plugins.SFProcessor {
+	return new(Aggregator)
+}
+
+// GetName returns the plugin name.
+func (s *Aggregator) GetName() string {
+	return pluginName
+}
+
+// Register registers plugin to plugin cache.
+func (s *Aggregator) Register(pc plugins.SFPluginCache) {
+	pc.AddProcessor(pluginName, NewAggregator)
+	pc.AddChannel(channelName, flattener.NewFlattenerChan)
+}
+
+// Init initializes the plugin with a configuration map.
+func (s *Aggregator) Init(conf map[string]interface{}) (err error) {
+	if s.config, err = CreateConfig(conf); err != nil {
+		return
+	}
+	if s.config.Window <= 0 {
+		return fmt.Errorf("attribute '%s' must be a positive number of seconds", WindowKey)
+	}
+	s.keys = make(map[string][]engine.StrFieldMap)
+	for rtype, fields := range s.config.Keys {
+		if rtype != sfgo.TyNFStr && rtype != sfgo.TyFFStr {
+			return fmt.Errorf("attribute '%s%s' must name a flow record type (%s or %s)", KeyPrefix, rtype, sfgo.TyNFStr, sfgo.TyFFStr)
+		}
+		for _, f := range fields {
+			if !engine.Mapper.IsField(f) {
+				return fmt.Errorf("unknown field '%s' in attribute '%s%s'", f, KeyPrefix, rtype)
+			}
+			s.keys[rtype] = append(s.keys[rtype], engine.Mapper.MapStr(f))
+		}
+	}
+	s.aggs = make(map[aggKey]*aggregate)
+	logger.Info.Printf("Initialized flow aggregator with %s windows", s.config.Window)
+	return
+}
+
+// SetOutChan sets the plugin output channels.
+func (s *Aggregator) SetOutChan(ch []interface{}) {
+	for _, c := range ch {
+		s.outCh = append(s.outCh, c.(*flattener.FlatChannel).In)
+	}
+}
+
+// Process implements the main loop of the plugin.
+func (s *Aggregator) Process(ch []interface{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if len(ch) != 1 {
+		logger.Error.Println("Aggregator only supports a single input channel at this time")
+		return
+	}
+	in := ch[0].(*flattener.FlatChannel).In
+	logger.Trace.Println("Starting flow aggregator with capacity: ", cap(in))
+	ticker := time.NewTicker(s.config.Window)
+	defer ticker.Stop()
+	for {
+		select {
+		case fr, ok := <-in:
+			if !ok {
+				logger.Trace.Println("Input channel closed. Shutting down.")
+				s.emit(func(a *aggregate) bool { return true })
+				return
+			}
+			s.process(fr, time.Now())
+		case now := <-ticker.C:
+			// windows whose flows stopped arriving are emitted even if record time does not advance
+			s.emit(func(a *aggregate) bool { return now.Sub(a.updated) >= s.config.Window })
+		}
+	}
+}
+
+// process adds a flow to its aggregate, or forwards other records, including the flattener's
+// summaries of suppressed flows. Windows that ended before the window of the latest record are emitted.
+func (s *Aggregator) process(fr *sfgo.FlatRecord, now time.Time) {
+	r := &engine.Record{Fr: *fr}
+	keys, ok := s.keys[engine.Mapper.MapStr(engine.SF_TYPE)(r)]
+	if !ok || r.IsSummary() {
+		s.out(fr)
+		return
+	}
+	window := s.config.Window.Nanoseconds()
+	ts := fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT]
+	start := ts - ts%window
+	h := xxhash.New()
+	for _, k := range keys {
+		h.WriteString(k(r))
+		h.Write([]byte{0})
+	}
+	k := aggKey{h: h.Sum64(), start: start}
+	if a, ok := s.aggs[k]; ok {
+		a.add(fr)
+		a.updated = now
+	} else {
+		s.seq++
+		s.aggs[k] = newAggregate(fr, start, s.seq, now)
+	}
+	if start > s.watermark {
+		s.watermark = start
+		s.emit(func(a *aggregate) bool { return a.start+window <= start })
+	}
+}
+
+// emit sends the aggregates selected by pred to the output channels, ordered by window and first flow.
+func (s *Aggregator) emit(pred func(a *aggregate) bool) {
+	var aggs []*aggregate
+	for k, a := range s.aggs {
+		if pred(a) {
+			aggs = append(aggs, a)
+			delete(s.aggs, k)
+		}
+	}
+	sort.Slice(aggs, func(i, j int) bool {
+		if aggs[i].start != aggs[j].start {
+			return aggs[i].start < aggs[j].start
+		}
+		return aggs[i].seq < aggs[j].seq
+	})
+	for _, a := range aggs {
+		s.out(a.fr)
+	}
+}
+
+// out sends a record to every output channel in the plugin.
+func (s *Aggregator) out(fr *sfgo.FlatRecord) {
+	for _, c := range s.outCh {
+		c <- fr
+	}
+}
+
+// Cleanup tears down resources.
+func (s *Aggregator) Cleanup() {
+	logger.Trace.Println("Exiting ", pluginName)
+	for _, c := range s.outCh {
+		close(c)
+	}
+}
+
+// newAggregate creates an aggregate from the first flow of a key in a window.
+func newAggregate(fr *sfgo.FlatRecord, start int64, seq uint64, now time.Time) *aggregate {
+	agg := &sfgo.FlatRecord{Sources: fr.Sources, Strs: fr.Strs, Anys: fr.Anys, Ptree: fr.Ptree, GraphletID: fr.GraphletID}
+	agg.Ints = make([][]int64, len(fr.Ints))
+	copy(agg.Ints, fr.Ints)
+	ints := make([]int64, layout.INT_ARRAY_SIZE)
+	copy(ints, fr.Ints[sfgo.SYSFLOW_IDX])
+	ints[layout.AGG_COUNT_INT] = 1
+	agg.Ints[sfgo.SYSFLOW_IDX] = ints
+	return &aggregate{fr: agg, start: start, seq: seq, updated: now}
+}
+
+// add rolls up a flow into the aggregate.
+func (a *aggregate) add(fr *sfgo.FlatRecord) {
+	ints, f := a.fr.Ints[sfgo.SYSFLOW_IDX], fr.Ints[sfgo.SYSFLOW_IDX]
+	ints[layout.AGG_COUNT_INT]++
+	if f[sfgo.TS_INT] < ints[sfgo.TS_INT] {
+		ints[sfgo.TS_INT] = f[sfgo.TS_INT]
+	}
+	if f[sfgo.ENDTS_INT] > ints[sfgo.ENDTS_INT] {
+		ints[sfgo.ENDTS_INT] = f[sfgo.ENDTS_INT]
+	}
+	ints[sfgo.OPFLAGS_INT] |= f[sfgo.OPFLAGS_INT]
+	ints[sfgo.NUMRRECVOPS_INT] += f[sfgo.NUMRRECVOPS_INT]
+	ints[sfgo.NUMWSENDOPS_INT] += f[sfgo.NUMWSENDOPS_INT]
+	ints[sfgo.NUMRRECVBYTES_INT] += f[sfgo.NUMRRECVBYTES_INT]
+	ints[sfgo.NUMWSENDBYTES_INT] += f[sfgo.NUMWSENDBYTES_INT]
+}
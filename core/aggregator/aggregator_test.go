@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func newFlow(rtype int64, exe string, path string, ts int64, rbytes int64) *sfgo.FlatRecord {
+	fr := &sfgo.FlatRecord{
+		Sources: []sfgo.Source{sfgo.SYSFLOW_SRC},
+		Ints:    [][]int64{make([]int64, layout.INT_ARRAY_SIZE)},
+		Strs:    [][]string{make([]string, sfgo.STR_ARRAY_SIZE)},
+		Anys:    [][]interface{}{make([]interface{}, sfgo.ANY_ARRAY_SIZE)},
+	}
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = rtype
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = ts
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.ENDTS_INT] = ts + 5
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.NUMRRECVBYTES_INT] = rbytes
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.NUMRRECVOPS_INT] = 1
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.FILE_PATH_STR] = path
+	return fr
+}
+
+func TestAggregator(t *testing.T) {
+	s := NewAggregator().(*Aggregator)
+	if !assert.NoError(t, s.Init(map[string]interface{}{"window": "10", "key.FF": "sf.proc.exe"})) {
+		return
+	}
+	out := make(chan *sfgo.FlatRecord, 100)
+	s.outCh = append(s.outCh, out)
+	sec := int64(time.Second)
+	now := time.Now()
+
+	s.process(newFlow(sfgo.FILE_FLOW, "/bin/cat", "/a", 1*sec, 10), now)
+	s.process(newFlow(sfgo.FILE_FLOW, "/bin/cat", "/b", 3*sec, 20), now)
+	s.process(newFlow(sfgo.FILE_FLOW, "/bin/ls", "/a", 2*sec, 5), now)
+	s.process(newFlow(sfgo.PROC_EVT, "/bin/ls", "", 4*sec, 0), now)
+	assert.Len(t, out, 1, "other records are forwarded")
+	<-out
+
+	// a flow of the next window closes the previous one
+	s.process(newFlow(sfgo.FILE_FLOW, "/bin/cat", "/a", 12*sec, 40), now)
+	if assert.Len(t, out, 2) {
+		r := engine.NewRecord(*<-out)
+		assert.Equal(t, "/bin/cat", engine.Mapper.MapStr(engine.SF_PROC_EXE)(r))
+		assert.Equal(t, int64(2), engine.Mapper.MapInt(engine.SF_AGG_COUNT)(r))
+		assert.Equal(t, 1*sec, engine.Mapper.MapInt(engine.SF_TS)(r))
+		assert.Equal(t, 3*sec+5, engine.Mapper.MapInt(engine.SF_ENDTS)(r))
+		assert.Equal(t, int64(30), r.GetInt(sfgo.NUMRRECVBYTES_INT, sfgo.SYSFLOW_SRC))
+		assert.Equal(t, int64(2), r.GetInt(sfgo.NUMRRECVOPS_INT, sfgo.SYSFLOW_SRC))
+		r = engine.NewRecord(*<-out)
+		assert.Equal(t, "/bin/ls", engine.Mapper.MapStr(engine.SF_PROC_EXE)(r))
+		assert.Equal(t, int64(1), engine.Mapper.MapInt(engine.SF_AGG_COUNT)(r))
+	}
+
+	// idle aggregates are emitted
+	s.emit(func(a *aggregate) bool { return true })
+	assert.Len(t, out, 1)
+
+	for _, conf := range []map[string]interface{}{
+		{"window": "0"},
+		{"key.PE": "sf.proc.exe"},
+		{"key.NF": "sf.net.dip,sf.no.such.field"},
+	} {
+		assert.Error(t, NewAggregator().Init(conf), "%v", conf)
+	}
+}
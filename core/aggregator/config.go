@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregator rolls up flow records into time windows.
+package aggregator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+// Configuration keys.
+const (
+	WindowKey string = "window"
+	KeyPrefix string = "key."
+)
+
+// Default aggregation keys, per record type.
+var defaultKeys = map[string][]string{
+	sfgo.TyNFStr: {"sf.container.id", "sf.proc.exe", "sf.net.dip", "sf.net.dport"},
+	sfgo.TyFFStr: {"sf.container.id", "sf.proc.exe", "sf.file.path"},
+}
+
+// Config defines a configuration object for the aggregator.
+type Config struct {
+	Window time.Duration
+	// Keys holds the fields identifying an aggregate, keyed by record type.
+	Keys map[string][]string
+}
+
+// CreateConfig creates a new config object from config dictionary.
+func CreateConfig(conf map[string]interface{}) (Config, error) {
+	var c Config = Config{Window: time.Minute, Keys: make(map[string][]string)} // default values
+	for rtype, fields := range defaultKeys {
+		c.Keys[rtype] = fields
+	}
+	var err error
+	if v, ok := conf[WindowKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.Window = time.Duration(duration) * time.Second
+	}
+	for k, v := range conf {
+		if s, ok := v.(string); ok && strings.HasPrefix(k, KeyPrefix) {
+			var fields []string
+			for _, f := range strings.Split(s, ",") {
+				if f = strings.TrimSpace(f); f != "" {
+					fields = append(fields, f)
+				}
+			}
+			c.Keys[strings.TrimPrefix(k, KeyPrefix)] = fields
+		}
+	}
+	return c, nil
+}
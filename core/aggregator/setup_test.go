@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package aggregator
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLoggers(logger.TRACE)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache implements a local cache for telemetry objects.
+package cache
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+// TablesDump holds the statistics and contents of a table set, in a form suitable for JSON encoding.
+type TablesDump struct {
+	Stats      TableStats        `json:"stats"`
+	Containers []*sfgo.Container `json:"containers"`
+	Pods       []*sfgo.Pod       `json:"pods"`
+	Processes  []*sfgo.Process   `json:"processes"`
+	Exited     []sfgo.OID        `json:"exited"`
+	Files      []FileDump        `json:"files"`
+}
+
+// FileDump wraps a file object so that its OID is encoded as a hex string.
+type FileDump struct {
+	Oid string `json:"oid"`
+	*sfgo.File
+}
+
+// Dump returns the current statistics and contents of the tables. Entities are sorted
+// by ID, files are listed from most to least recently used, and null unions are nil pointers,
+// since the JSON encoders of the SysFlow objects reject their null branches.
+func (t *SFTables) Dump() *TablesDump {
+	d := &TablesDump{
+		Stats:      t.Stats(),
+		Containers: make([]*sfgo.Container, 0, len(t.contTable)),
+		Pods:       make([]*sfgo.Pod, 0, len(t.podTable)),
+		Processes:  make([]*sfgo.Process, 0, len(t.procTable)),
+		Exited:     make([]sfgo.OID, 0, len(t.exited)),
+	}
+	e := t.Entities()
+	for _, c := range e.Conts {
+		d.Containers = append(d.Containers, serializableCont(c))
+	}
+	sort.Slice(d.Containers, func(i, j int) bool { return d.Containers[i].Id < d.Containers[j].Id })
+	d.Pods = append(d.Pods, e.Pods...)
+	sort.Slice(d.Pods, func(i, j int) bool { return d.Pods[i].Id < d.Pods[j].Id })
+	for _, p := range e.Procs {
+		d.Processes = append(d.Processes, serializableProc(p))
+	}
+	sort.Slice(d.Processes, func(i, j int) bool { return oidLess(d.Processes[i].Oid, d.Processes[j].Oid) })
+	d.Exited = append(d.Exited, e.Exited...)
+	sort.Slice(d.Exited, func(i, j int) bool { return oidLess(&d.Exited[i], &d.Exited[j]) })
+	d.Files = make([]FileDump, 0, len(e.Files))
+	for i := len(e.Files) - 1; i >= 0; i-- {
+		d.Files = append(d.Files, FileDump{Oid: hex.EncodeToString(e.Files[i].Oid[:]), File: serializableFile(e.Files[i])})
+	}
+	return d
+}
+
+func oidLess(a *sfgo.OID, b *sfgo.OID) bool {
+	if a.Hpid != b.Hpid {
+		return a.Hpid < b.Hpid
+	}
+	return a.CreateTS < b.CreateTS
+}
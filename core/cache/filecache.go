@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache implements a local cache for telemetry objects.
+package cache
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+// fileEntry is an element of the file cache.
+type fileEntry struct {
+	id    sfgo.FOID
+	file  *sfgo.File
+	atime time.Time
+}
+
+// fileCache is a least recently used cache of file objects, bounded in size and
+// in the time an entry may stay idle. A zero bound disables the corresponding limit.
+type fileCache struct {
+	maxSize int
+	ttl     time.Duration
+	now     func() time.Time
+	lru     *list.List
+	entries map[sfgo.FOID]*list.Element
+	evicted func(f *sfgo.File)
+}
+
+func newFileCache(maxSize int, ttl time.Duration, now func() time.Time, evicted func(f *sfgo.File)) *fileCache {
+	return &fileCache{
+		maxSize: maxSize,
+		ttl:     ttl,
+		now:     now,
+		lru:     list.New(),
+		entries: make(map[sfgo.FOID]*list.Element),
+		evicted: evicted,
+	}
+}
+
+// get retrieves a file object and marks it as recently used.
+func (c *fileCache) get(ID sfgo.FOID) *sfgo.File {
+	c.expire()
+	if e, ok := c.entries[ID]; ok {
+		fe := e.Value.(*fileEntry)
+		fe.atime = c.now()
+		c.lru.MoveToFront(e)
+		return fe.file
+	}
+	return nil
+}
+
+// contains checks whether a file object is cached.
+func (c *fileCache) contains(ID sfgo.FOID) bool {
+	c.expire()
+	_, ok := c.entries[ID]
+	return ok
+}
+
+// set stores a file object, evicting the least recently used objects above the size bound.
+// It returns the replaced object, if any.
+func (c *fileCache) set(ID sfgo.FOID, f *sfgo.File) (old *sfgo.File) {
+	if e, ok := c.entries[ID]; ok {
+		fe := e.Value.(*fileEntry)
+		old = fe.file
+		fe.file = f
+		fe.atime = c.now()
+		c.lru.MoveToFront(e)
+	} else {
+		c.entries[ID] = c.lru.PushFront(&fileEntry{id: ID, file: f, atime: c.now()})
+	}
+	for c.maxSize > 0 && c.lru.Len() > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+	c.expire()
+	return
+}
+
+// expire evicts the objects that have been idle for longer than the ttl.
+func (c *fileCache) expire() {
+	if c.ttl <= 0 {
+		return
+	}
+	now := c.now()
+	for e := c.lru.Back(); e != nil && now.Sub(e.Value.(*fileEntry).atime) > c.ttl; e = c.lru.Back() {
+		c.remove(e)
+	}
+}
+
+func (c *fileCache) remove(e *list.Element) {
+	fe := c.lru.Remove(e).(*fileEntry)
+	delete(c.entries, fe.id)
+	c.evicted(fe.file)
+}
+
+func (c *fileCache) len() int {
+	return c.lru.Len()
+}
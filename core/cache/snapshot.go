@@ -0,0 +1,251 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache implements a local cache for telemetry objects.
+package cache
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/actgardner/gogen-avro/v7/container"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+const snapshotBlockSize = 1000
+
+// Entities holds the cached entities of a table set, in an order in which they can be restored.
+type Entities struct {
+	Pods   []*sfgo.Pod
+	Conts  []*sfgo.Container
+	Procs  []*sfgo.Process
+	Exited []sfgo.OID
+	Files  []*sfgo.File
+}
+
+// Snapshot holds the cached entities of several exporters, keyed by exporter ID.
+type Snapshot struct {
+	Time    time.Time
+	Sources map[string]*Entities
+}
+
+// Entities returns the cached entities, with files in least recently used order.
+func (t *SFTables) Entities() *Entities {
+	e := new(Entities)
+	for _, pd := range t.podTable {
+		e.Pods = append(e.Pods, pd)
+	}
+	for _, c := range t.contTable {
+		e.Conts = append(e.Conts, c)
+	}
+	for _, p := range t.procTable {
+		for _, v := range p {
+			if v != nil {
+				e.Procs = append(e.Procs, v)
+			}
+		}
+	}
+	for oid := range t.exited {
+		e.Exited = append(e.Exited, oid)
+	}
+	for el := t.fileTable.lru.Back(); el != nil; el = el.Prev() {
+		e.Files = append(e.Files, el.Value.(*fileEntry).file)
+	}
+	return e
+}
+
+// Restore stores previously cached entities in the tables.
+func (t *SFTables) Restore(e *Entities) {
+	for _, pd := range e.Pods {
+		t.SetPod(pd.Id, pd)
+	}
+	for _, c := range e.Conts {
+		t.SetCont(c.Id, c)
+	}
+	for _, p := range e.Procs {
+		t.SetProc(*p.Oid, p)
+	}
+	for _, oid := range e.Exited {
+		if _, ok := t.procTable[oid]; ok && len(t.children[oid]) > 0 {
+			t.exited[oid] = true
+		}
+	}
+	for _, f := range e.Files {
+		t.SetFile(f.Oid, f)
+	}
+}
+
+// WriteSnapshot writes a snapshot to a SysFlow trace file, in which the entities of each exporter
+// follow its header, and exited processes are recorded as exit events. The file is replaced
+// atomically, and the snapshot time is stored as its modification time.
+func WriteSnapshot(path string, s *Snapshot) (err error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmp)
+		}
+	}()
+	bw := bufio.NewWriter(f)
+	w, err := sfgo.NewSysFlowWriter(bw, container.Deflate, snapshotBlockSize)
+	if err != nil {
+		return
+	}
+	write := func(rec *sfgo.RecUnion) error {
+		return w.WriteRecord(&sfgo.SysFlow{Rec: rec})
+	}
+	for exporter, e := range s.Sources {
+		hdr := &sfgo.SFHeader{Version: 4, Exporter: exporter, Ip: "NA"}
+		if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_HEADER, SFHeader: hdr}); err != nil {
+			return
+		}
+		for _, pd := range e.Pods {
+			if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_POD, Pod: pd}); err != nil {
+				return
+			}
+		}
+		for _, c := range e.Conts {
+			if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_CONT, Container: serializableCont(c)}); err != nil {
+				return
+			}
+		}
+		for _, p := range e.Procs {
+			if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_PROCESS, Process: serializableProc(p)}); err != nil {
+				return
+			}
+		}
+		for i := range e.Exited {
+			oid := e.Exited[i]
+			pe := &sfgo.ProcessEvent{ProcOID: &oid, Tid: oid.Hpid, OpFlags: sfgo.OP_EXIT}
+			if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_PROC_EVT, ProcessEvent: pe}); err != nil {
+				return
+			}
+		}
+		for _, fl := range e.Files {
+			if err = write(&sfgo.RecUnion{UnionType: sfgo.SF_FILE, File: serializableFile(fl)}); err != nil {
+				return
+			}
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return
+	}
+	if err = bw.Flush(); err != nil {
+		return
+	}
+	if err = f.Close(); err != nil {
+		return
+	}
+	if err = os.Chtimes(tmp, s.Time, s.Time); err != nil {
+		return
+	}
+	return os.Rename(tmp, path)
+}
+
+// serializableCont returns a container whose null unions are nil pointers, since the serializers
+// reject the null branches set by the deserializers. The cached object is copied, not modified.
+func serializableCont(c *sfgo.Container) *sfgo.Container {
+	if c.PodId != nil && c.PodId.UnionType != sfgo.PodIdUnionTypeEnumString {
+		cc := *c
+		cc.PodId, c = nil, &cc
+	}
+	return c
+}
+
+// serializableProc returns a process whose null unions are nil pointers.
+func serializableProc(p *sfgo.Process) *sfgo.Process {
+	if (p.Poid != nil && p.Poid.UnionType != sfgo.PoidUnionTypeEnumOID) || !isContID(p.ContainerId) {
+		pc := *p
+		if pc.Poid != nil && pc.Poid.UnionType != sfgo.PoidUnionTypeEnumOID {
+			pc.Poid = nil
+		}
+		if !isContID(pc.ContainerId) {
+			pc.ContainerId = nil
+		}
+		p = &pc
+	}
+	return p
+}
+
+// serializableFile returns a file whose null unions are nil pointers.
+func serializableFile(f *sfgo.File) *sfgo.File {
+	if !isContID(f.ContainerId) {
+		fc := *f
+		fc.ContainerId, f = nil, &fc
+	}
+	return f
+}
+
+// isContID checks whether a container ID union is null or holds an ID.
+func isContID(c *sfgo.ContainerIdUnion) bool {
+	return c == nil || c.UnionType == sfgo.ContainerIdUnionTypeEnumString
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot.
+func ReadSnapshot(path string) (*Snapshot, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := sfgo.NewSysFlowReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{Time: fi.ModTime(), Sources: make(map[string]*Entities)}
+	var e *Entities
+	for {
+		sf, err := r.Read()
+		if err == io.EOF {
+			return s, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if sf.Rec.UnionType == sfgo.SF_HEADER {
+			e = new(Entities)
+			s.Sources[sf.Rec.SFHeader.Exporter] = e
+			continue
+		} else if e == nil {
+			continue
+		}
+		switch sf.Rec.UnionType {
+		case sfgo.SF_POD:
+			e.Pods = append(e.Pods, sf.Rec.Pod)
+		case sfgo.SF_CONT:
+			e.Conts = append(e.Conts, sf.Rec.Container)
+		case sfgo.SF_PROCESS:
+			e.Procs = append(e.Procs, sf.Rec.Process)
+		case sfgo.SF_PROC_EVT:
+			e.Exited = append(e.Exited, *sf.Rec.ProcessEvent.ProcOID)
+		case sfgo.SF_FILE:
+			e.Files = append(e.Files, sf.Rec.File)
+		}
+	}
+}
@@ -22,9 +22,85 @@
 package cache
 
 import (
+	"container/list"
+	"fmt"
+	"strings"
+	"time"
+	"unsafe"
+
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 )
 
+// Config defines the bounds of the entity tables.
+type Config struct {
+	// FileMaxSize is the maximum number of cached file objects (0 for unbounded).
+	FileMaxSize int
+	// FileTTL is the time a file object may stay in the cache without being accessed (0 for no expiry).
+	FileTTL time.Duration
+	// ContTTL is the time a container object may stay in the cache without cached processes (0 for no expiry).
+	ContTTL time.Duration
+}
+
+// TableStats holds the sizes, the estimated memory usage, the eviction and lookup counters of the
+// entity tables, and a histogram of the depths of the cached process trees.
+type TableStats struct {
+	Conts         int    `json:"containers"`
+	Pods          int    `json:"pods"`
+	Procs         int    `json:"processes"`
+	Files         int    `json:"files"`
+	Ptrees        int    `json:"ptrees"`
+	Bytes         int64  `json:"bytes"`
+	ContEvictions uint64 `json:"containerEvictions"`
+	PodEvictions  uint64 `json:"podEvictions"`
+	ProcEvictions uint64 `json:"processEvictions"`
+	FileEvictions uint64 `json:"fileEvictions"`
+	ContHits      uint64 `json:"containerHits"`
+	ContMisses    uint64 `json:"containerMisses"`
+	ProcHits      uint64 `json:"processHits"`
+	ProcMisses    uint64 `json:"processMisses"`
+	FileHits      uint64 `json:"fileHits"`
+	FileMisses    uint64 `json:"fileMisses"`
+	// PtreeDepths counts the cached process trees by depth, in the buckets delimited by PtreeDepthBounds.
+	PtreeDepths []int `json:"ptreeDepths"`
+}
+
+// PtreeDepthBounds holds the upper bounds of the process tree depth histogram buckets;
+// the last bucket holds the deeper trees.
+var PtreeDepthBounds = []int{1, 2, 4, 8, 16}
+
+// String returns a one-line summary of the table statistics.
+func (s TableStats) String() string {
+	return fmt.Sprintf("containers=%d pods=%d processes=%d files=%d ptrees=%d bytes=%d evicted(containers=%d pods=%d processes=%d files=%d) hit rates(containers=%s processes=%s files=%s) ptree depths(%s)",
+		s.Conts, s.Pods, s.Procs, s.Files, s.Ptrees, s.Bytes, s.ContEvictions, s.PodEvictions, s.ProcEvictions, s.FileEvictions,
+		hitRate(s.ContHits, s.ContMisses), hitRate(s.ProcHits, s.ProcMisses), hitRate(s.FileHits, s.FileMisses), depthsStr(s.PtreeDepths))
+}
+
+func hitRate(hits uint64, misses uint64) string {
+	if hits+misses == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f%% of %d", 100*float64(hits)/float64(hits+misses), hits+misses)
+}
+
+func depthsStr(depths []int) string {
+	var b []string
+	lower := 1
+	for i, n := range depths {
+		switch {
+		case i == len(PtreeDepthBounds):
+			b = append(b, fmt.Sprintf("%d+:%d", lower, n))
+		case lower == PtreeDepthBounds[i]:
+			b = append(b, fmt.Sprintf("%d:%d", lower, n))
+		default:
+			b = append(b, fmt.Sprintf("%d-%d:%d", lower, PtreeDepthBounds[i], n))
+		}
+		if i < len(PtreeDepthBounds) {
+			lower = PtreeDepthBounds[i] + 1
+		}
+	}
+	return strings.Join(b, " ")
+}
+
 // SFTables defines thread-safe shared cache for plugins for storing SysFlow entities.
 type SFTables struct {
 	contTable map[string]*sfgo.Container
@@ -33,13 +109,36 @@ type SFTables struct {
 	// fileTable  map[uint64]*sfgo.File
 	// ptreeTable map[uint64][]*sfgo.Process
 	procTable  map[sfgo.OID][]*sfgo.Process
-	fileTable  map[sfgo.FOID]*sfgo.File
+	fileTable  *fileCache
 	ptreeTable map[sfgo.OID][]*sfgo.Process
+
+	// children indexes the cached processes of each parent process, and contRefs counts the
+	// cached processes of each container; exited holds the processes that exited while
+	// they still had cached children, and are evicted with their last child.
+	children map[sfgo.OID]map[sfgo.OID]struct{}
+	contRefs map[string]int
+	exited   map[sfgo.OID]bool
+
+	// idleConts holds the cached containers without cached processes in the order they became
+	// idle, and deletedConts the containers of deleted pods, which are evicted with their last
+	// cached process.
+	idleConts    *list.List
+	idleContIdx  map[string]*list.Element
+	deletedConts map[string]bool
+
+	config Config
+	now    func() time.Time
+	stats  TableStats
 }
 
-// NewSFTables creates a new SFTables instance.
+// NewSFTables creates a new SFTables instance with unbounded file table.
 func NewSFTables() *SFTables {
-	t := new(SFTables)
+	return NewSFTablesFromConfig(Config{})
+}
+
+// NewSFTablesFromConfig creates a new SFTables instance with the bounds defined in conf.
+func NewSFTablesFromConfig(conf Config) *SFTables {
+	t := &SFTables{config: conf, now: time.Now}
 	t.new()
 	return t
 }
@@ -48,8 +147,15 @@ func (t *SFTables) new() {
 	t.contTable = make(map[string]*sfgo.Container)
 	t.podTable = make(map[string]*sfgo.Pod)
 	t.procTable = make(map[sfgo.OID][]*sfgo.Process)
-	t.fileTable = make(map[sfgo.FOID]*sfgo.File)
+	t.fileTable = newFileCache(t.config.FileMaxSize, t.config.FileTTL, t.now, t.evictedFile)
 	t.ptreeTable = make(map[sfgo.OID][]*sfgo.Process)
+	t.children = make(map[sfgo.OID]map[sfgo.OID]struct{})
+	t.contRefs = make(map[string]int)
+	t.exited = make(map[sfgo.OID]bool)
+	t.idleConts = list.New()
+	t.idleContIdx = make(map[string]*list.Element)
+	t.deletedConts = make(map[string]bool)
+	t.stats.Bytes = 0
 	// t.procTable = make(map[uint64][]*sfgo.Process)
 	// t.fileTable = make(map[uint64]*sfgo.File)
 	// t.ptreeTable = make(map[uint64][]*sfgo.Process)
@@ -60,15 +166,49 @@ func (t *SFTables) Reset() {
 	t.new()
 }
 
+// Stats returns the current table statistics.
+func (t *SFTables) Stats() TableStats {
+	s := t.stats
+	s.Conts = len(t.contTable)
+	s.Pods = len(t.podTable)
+	s.Procs = len(t.procTable)
+	s.Files = t.fileTable.len()
+	s.Ptrees = len(t.ptreeTable)
+	s.PtreeDepths = make([]int, len(PtreeDepthBounds)+1)
+	for _, ptree := range t.ptreeTable {
+		i := 0
+		for i < len(PtreeDepthBounds) && len(ptree) > PtreeDepthBounds[i] {
+			i++
+		}
+		s.PtreeDepths[i]++
+	}
+	return s
+}
+
 // GetCont retrieves a cached container object by ID.
 func (t *SFTables) GetCont(ID string) (co *sfgo.Container) {
+	t.expireConts()
 	co = t.contTable[ID]
+	if co != nil {
+		t.stats.ContHits++
+	} else {
+		t.stats.ContMisses++
+	}
 	return
 }
 
 // SetCont stores a container object in the cache.
 func (t *SFTables) SetCont(ID string, o *sfgo.Container) {
+	if old, ok := t.contTable[ID]; ok {
+		t.stats.Bytes -= contBytes(old)
+	}
 	t.contTable[ID] = o
+	t.stats.Bytes += contBytes(o)
+	if t.contRefs[ID] == 0 {
+		t.clearContIdle(ID)
+		t.setContIdle(ID)
+	}
+	t.expireConts()
 }
 
 // GetPod retrieves a cached pod object by ID.
@@ -79,11 +219,51 @@ func (t *SFTables) GetPod(ID string) (pd *sfgo.Pod) {
 
 // SetPod stores a pod object in the cache.
 func (t *SFTables) SetPod(ID string, o *sfgo.Pod) {
+	if old, ok := t.podTable[ID]; ok {
+		t.stats.Bytes -= podBytes(old)
+	}
 	t.podTable[ID] = o
+	t.stats.Bytes += podBytes(o)
+}
+
+// DeletePod evicts a deleted pod and its containers. Containers that still have cached
+// processes are evicted along with their last cached process.
+func (t *SFTables) DeletePod(ID string) {
+	pd, ok := t.podTable[ID]
+	if !ok {
+		return
+	}
+	delete(t.podTable, ID)
+	t.stats.Bytes -= podBytes(pd)
+	t.stats.PodEvictions++
+	for cID, c := range t.contTable {
+		if c.PodId == nil || c.PodId.UnionType != sfgo.PodIdUnionTypeEnumString || c.PodId.String != ID {
+			continue
+		}
+		if t.contRefs[cID] == 0 {
+			t.evictCont(cID)
+		} else {
+			t.deletedConts[cID] = true
+		}
+	}
 }
 
 // GetProc retrieves a cached process object by ID.
 func (t *SFTables) GetProc(ID sfgo.OID) (po *sfgo.Process) {
+	if po = t.getProc(ID); po != nil {
+		t.stats.ProcHits++
+	} else {
+		t.stats.ProcMisses++
+	}
+	return
+}
+
+// HasProc checks whether a process object is cached, without counting as a lookup.
+func (t *SFTables) HasProc(ID sfgo.OID) bool {
+	return t.getProc(ID) != nil
+}
+
+func (t *SFTables) getProc(ID sfgo.OID) (po *sfgo.Process) {
 	// if p, ok := t.procTable[hash.GetHash(ID)]; ok {
 	if p, ok := t.procTable[ID]; ok {
 		if v := p[sfgo.SFObjectStateMODIFIED]; v != nil {
@@ -97,34 +277,158 @@ func (t *SFTables) GetProc(ID sfgo.OID) (po *sfgo.Process) {
 	return
 }
 
-// SetProc stores a process object in the cache.
+// SetProc stores a process object in the cache, and invalidates the cached process
+// trees of the process and its descendants.
 func (t *SFTables) SetProc(ID sfgo.OID, o *sfgo.Process) {
 	// oID := hash.GetHash(ID)
 	oID := ID
+	t.invalidatePtree(oID)
 	if p, ok := t.procTable[oID]; ok {
+		if old := p[o.State]; old != nil {
+			t.stats.Bytes -= procBytes(old)
+		}
 		p[o.State] = o
 	} else {
 		p = make([]*sfgo.Process, sfgo.SFObjectStateREUP+1)
 		p[o.State] = o
 		t.procTable[oID] = p
+		if poid := parentOID(o); poid != nil {
+			if t.children[*poid] == nil {
+				t.children[*poid] = make(map[sfgo.OID]struct{})
+			}
+			t.children[*poid][oID] = struct{}{}
+		}
+		if cID := contID(o.ContainerId); cID != "" {
+			t.contRefs[cID]++
+			t.clearContIdle(cID)
+		}
+	}
+	t.stats.Bytes += procBytes(o)
+}
+
+// ExitProc evicts an exited process. A process that still has cached children is kept,
+// so that their process trees remain complete, and evicted along with its last child.
+// Containers are not evicted with their last cached process, unless their pod was deleted;
+// otherwise they expire after ContTTL without cached processes.
+func (t *SFTables) ExitProc(ID sfgo.OID) {
+	if _, ok := t.procTable[ID]; !ok {
+		return
+	}
+	if len(t.children[ID]) > 0 {
+		t.exited[ID] = true
+		return
+	}
+	t.evictProc(ID)
+}
+
+func (t *SFTables) evictProc(ID sfgo.OID) {
+	p := t.getProc(ID)
+	for _, v := range t.procTable[ID] {
+		if v != nil {
+			t.stats.Bytes -= procBytes(v)
+		}
+	}
+	delete(t.procTable, ID)
+	delete(t.exited, ID)
+	delete(t.children, ID)
+	t.deletePtree(ID)
+	t.stats.ProcEvictions++
+	if p == nil {
+		return
+	}
+	if cID := contID(p.ContainerId); cID != "" {
+		if t.contRefs[cID]--; t.contRefs[cID] <= 0 {
+			delete(t.contRefs, cID)
+			if t.deletedConts[cID] {
+				t.evictCont(cID)
+			} else if _, ok := t.contTable[cID]; ok {
+				t.setContIdle(cID)
+			}
+		}
+	}
+	if poid := parentOID(p); poid != nil {
+		delete(t.children[*poid], ID)
+		if len(t.children[*poid]) == 0 {
+			delete(t.children, *poid)
+			if t.exited[*poid] {
+				t.evictProc(*poid)
+			}
+		}
+	}
+}
+
+func (t *SFTables) evictCont(ID string) {
+	t.clearContIdle(ID)
+	delete(t.deletedConts, ID)
+	if c, ok := t.contTable[ID]; ok {
+		delete(t.contTable, ID)
+		t.stats.Bytes -= contBytes(c)
+		t.stats.ContEvictions++
+	}
+}
+
+// idleCont is an element of the list of cached containers without cached processes.
+type idleCont struct {
+	id    string
+	since time.Time
+}
+
+func (t *SFTables) setContIdle(ID string) {
+	if _, ok := t.idleContIdx[ID]; !ok {
+		t.idleContIdx[ID] = t.idleConts.PushBack(&idleCont{id: ID, since: t.now()})
+	}
+}
+
+func (t *SFTables) clearContIdle(ID string) {
+	if e, ok := t.idleContIdx[ID]; ok {
+		t.idleConts.Remove(e)
+		delete(t.idleContIdx, ID)
+	}
+}
+
+// expireConts evicts the containers that have been without cached processes for longer than the ttl.
+func (t *SFTables) expireConts() {
+	if t.config.ContTTL <= 0 {
+		return
+	}
+	now := t.now()
+	for e := t.idleConts.Front(); e != nil && now.Sub(e.Value.(*idleCont).since) > t.config.ContTTL; e = t.idleConts.Front() {
+		t.evictCont(e.Value.(*idleCont).id)
 	}
 }
 
 // GetFile retrieves a cached file object by ID.
 func (t *SFTables) GetFile(ID sfgo.FOID) *sfgo.File {
 	// if v, ok := t.fileTable[hash.GetHash(ID)]; ok {
-	if v, ok := t.fileTable[ID]; ok {
-		return v
+	f := t.fileTable.get(ID)
+	if f != nil {
+		t.stats.FileHits++
+	} else {
+		t.stats.FileMisses++
 	}
-	return nil
+	return f
+}
+
+// HasFile checks whether a file object is cached, without counting as a lookup or
+// marking the object as recently used.
+func (t *SFTables) HasFile(ID sfgo.FOID) bool {
+	return t.fileTable.contains(ID)
 }
 
 // SetFile stores a file object in the cache.
 func (t *SFTables) SetFile(ID sfgo.FOID, o *sfgo.File) {
-	t.fileTable[ID] = o
+	t.stats.Bytes += fileBytes(o)
+	if old := t.fileTable.set(ID, o); old != nil {
+		t.stats.Bytes -= fileBytes(old)
+	}
 	// t.fileTable[hash.GetHash(ID)] = o
 }
 
+func (t *SFTables) evictedFile(f *sfgo.File) {
+	t.stats.Bytes -= fileBytes(f)
+	t.stats.FileEvictions++
+}
+
 // GetPtree retrieves and caches the processes hierachy given a process ID.
 func (t *SFTables) GetPtree(ID sfgo.OID) []*sfgo.Process {
 	// oID := hash.GetHash(ID)
@@ -134,13 +438,29 @@ func (t *SFTables) GetPtree(ID sfgo.OID) []*sfgo.Process {
 	}
 	ptree := t.getProcProv(ID)
 	t.ptreeTable[oID] = ptree
+	t.stats.Bytes += ptreeBytes(ptree)
 	return ptree
 }
 
+// invalidatePtree removes the cached process trees of a process and its descendants.
+func (t *SFTables) invalidatePtree(ID sfgo.OID) {
+	t.deletePtree(ID)
+	for c := range t.children[ID] {
+		t.invalidatePtree(c)
+	}
+}
+
+func (t *SFTables) deletePtree(ID sfgo.OID) {
+	if ptree, ok := t.ptreeTable[ID]; ok {
+		t.stats.Bytes -= ptreeBytes(ptree)
+		delete(t.ptreeTable, ID)
+	}
+}
+
 // getProcProv builds the provenance tree of a process recursevely.
 func (t *SFTables) getProcProv(ID sfgo.OID) []*sfgo.Process {
 	var ptree = make([]*sfgo.Process, 0)
-	if p := t.GetProc(ID); p != nil {
+	if p := t.getProc(ID); p != nil {
 		if p.Poid != nil && p.Poid.UnionType == sfgo.PoidUnionTypeEnumOID {
 			return append(append(ptree, p), t.getProcProv(*p.Poid.OID)...)
 		}
@@ -148,3 +468,46 @@ func (t *SFTables) getProcProv(ID sfgo.OID) []*sfgo.Process {
 	}
 	return ptree
 }
+
+func parentOID(p *sfgo.Process) *sfgo.OID {
+	if p.Poid != nil && p.Poid.UnionType == sfgo.PoidUnionTypeEnumOID {
+		return p.Poid.OID
+	}
+	return nil
+}
+
+func contID(c *sfgo.ContainerIdUnion) string {
+	if c != nil && c.UnionType == sfgo.ContainerIdUnionTypeEnumString {
+		return c.String
+	}
+	return ""
+}
+
+// The functions below estimate the memory footprint of cached objects.
+
+func procBytes(p *sfgo.Process) int64 {
+	return int64(unsafe.Sizeof(*p)+unsafe.Sizeof(sfgo.OID{})) + int64(len(p.Exe)+len(p.ExeArgs)+len(p.UserName)+len(p.GroupName)+len(contID(p.ContainerId)))
+}
+
+func fileBytes(f *sfgo.File) int64 {
+	return int64(unsafe.Sizeof(*f)) + int64(len(f.Path)+len(contID(f.ContainerId)))
+}
+
+func contBytes(c *sfgo.Container) int64 {
+	return int64(unsafe.Sizeof(*c)) + int64(len(c.Id)+len(c.Name)+len(c.Image)+len(c.Imageid))
+}
+
+func podBytes(p *sfgo.Pod) int64 {
+	n := int64(unsafe.Sizeof(*p)) + int64(len(p.Id)+len(p.Name)+len(p.NodeName)+len(p.Namespace)+8*(len(p.HostIP)+len(p.InternalIP)))
+	for k, v := range p.Labels {
+		n += int64(len(k) + len(v))
+	}
+	for k, v := range p.Selectors {
+		n += int64(len(k) + len(v))
+	}
+	return n
+}
+
+func ptreeBytes(ptree []*sfgo.Process) int64 {
+	return int64(unsafe.Sizeof(ptree)) + int64(cap(ptree))*int64(unsafe.Sizeof(&sfgo.Process{}))
+}
@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package cache
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func newProc(pid int64, ppid int64, cont string, exe string) *sfgo.Process {
+	p := &sfgo.Process{State: sfgo.SFObjectStateCREATED, Oid: &sfgo.OID{Hpid: pid, CreateTS: pid}, Exe: exe}
+	if ppid > 0 {
+		p.Poid = &sfgo.PoidUnion{UnionType: sfgo.PoidUnionTypeEnumOID, OID: &sfgo.OID{Hpid: ppid, CreateTS: ppid}}
+	}
+	if cont != "" {
+		p.ContainerId = &sfgo.ContainerIdUnion{UnionType: sfgo.ContainerIdUnionTypeEnumString, String: cont}
+	}
+	return p
+}
+
+func TestExitProc(t *testing.T) {
+	tables := NewSFTables()
+	tables.SetCont("c1", &sfgo.Container{Id: "c1"})
+	for _, p := range []*sfgo.Process{
+		newProc(1, 0, "c1", "/sbin/init"),
+		newProc(2, 1, "c1", "/bin/bash"),
+		newProc(3, 2, "c1", "/usr/bin/cat"),
+	} {
+		tables.SetProc(*p.Oid, p)
+	}
+	bytes := tables.Stats().Bytes
+	assert.Positive(t, bytes)
+
+	// bash exits while cat is still running
+	tables.ExitProc(sfgo.OID{Hpid: 2, CreateTS: 2})
+	assert.NotNil(t, tables.GetProc(sfgo.OID{Hpid: 2, CreateTS: 2}))
+	assert.Len(t, tables.GetPtree(sfgo.OID{Hpid: 3, CreateTS: 3}), 3)
+
+	// cat exits, taking its exited parent along
+	tables.ExitProc(sfgo.OID{Hpid: 3, CreateTS: 3})
+	assert.Nil(t, tables.GetProc(sfgo.OID{Hpid: 2, CreateTS: 2}))
+	assert.Nil(t, tables.GetProc(sfgo.OID{Hpid: 3, CreateTS: 3}))
+	assert.NotNil(t, tables.GetCont("c1"))
+
+	// the last process of the container exits, the container stays cached
+	tables.ExitProc(sfgo.OID{Hpid: 1, CreateTS: 1})
+	assert.NotNil(t, tables.GetCont("c1"))
+
+	s := tables.Stats()
+	assert.Equal(t, 0, s.Procs)
+	assert.Equal(t, 0, s.Ptrees)
+	assert.Equal(t, uint64(3), s.ProcEvictions)
+	assert.Equal(t, uint64(0), s.ContEvictions)
+	assert.Equal(t, contBytes(tables.GetCont("c1")), s.Bytes)
+}
+
+func TestDeletePod(t *testing.T) {
+	tables := NewSFTables()
+	pod := &sfgo.PodIdUnion{UnionType: sfgo.PodIdUnionTypeEnumString, String: "p1"}
+	tables.SetPod("p1", &sfgo.Pod{Id: "p1"})
+	tables.SetCont("c1", &sfgo.Container{Id: "c1", PodId: pod})
+	tables.SetCont("c2", &sfgo.Container{Id: "c2", PodId: pod})
+	p := newProc(1, 0, "c2", "/bin/sleep")
+	tables.SetProc(*p.Oid, p)
+
+	tables.DeletePod("p1")
+	assert.Nil(t, tables.GetPod("p1"))
+	assert.Nil(t, tables.GetCont("c1"))
+	assert.NotNil(t, tables.GetCont("c2"))
+	assert.Equal(t, uint64(1), tables.Stats().PodEvictions)
+
+	// the container of the deleted pod is evicted with its last process
+	tables.ExitProc(*p.Oid)
+	assert.Nil(t, tables.GetCont("c2"))
+	assert.Equal(t, uint64(2), tables.Stats().ContEvictions)
+}
+
+func TestContTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	tables := &SFTables{config: Config{ContTTL: time.Minute}, now: func() time.Time { return now }}
+	tables.new()
+	tables.SetCont("c1", &sfgo.Container{Id: "c1"})
+	tables.SetCont("c2", &sfgo.Container{Id: "c2"})
+	p := newProc(1, 0, "c2", "/bin/sleep")
+	tables.SetProc(*p.Oid, p)
+
+	now = now.Add(2 * time.Minute)
+	assert.Nil(t, tables.GetCont("c1"), "container without processes expires")
+	assert.NotNil(t, tables.GetCont("c2"), "container with processes is kept")
+
+	tables.ExitProc(*p.Oid)
+	now = now.Add(30 * time.Second)
+	assert.NotNil(t, tables.GetCont("c2"))
+	now = now.Add(time.Minute)
+	assert.Nil(t, tables.GetCont("c2"), "container expires after its last process exits")
+
+	s := tables.Stats()
+	assert.Equal(t, 0, s.Conts)
+	assert.Equal(t, uint64(2), s.ContEvictions)
+	assert.Equal(t, int64(0), s.Bytes)
+}
+
+func TestFileCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	tables := &SFTables{config: Config{FileMaxSize: 2, FileTTL: time.Minute}, now: func() time.Time { return now }}
+	tables.new()
+	foid := func(b byte) sfgo.FOID { return sfgo.FOID{b} }
+
+	tables.SetFile(foid(1), &sfgo.File{Path: "/etc/passwd"})
+	tables.SetFile(foid(2), &sfgo.File{Path: "/etc/shadow"})
+	assert.NotNil(t, tables.GetFile(foid(1)))
+	tables.SetFile(foid(3), &sfgo.File{Path: "/etc/hosts"})
+	assert.Nil(t, tables.GetFile(foid(2)), "least recently used file is evicted")
+	assert.NotNil(t, tables.GetFile(foid(1)))
+
+	now = now.Add(2 * time.Minute)
+	assert.Nil(t, tables.GetFile(foid(1)), "idle file expires")
+
+	s := tables.Stats()
+	assert.Equal(t, 0, s.Files)
+	assert.Equal(t, uint64(3), s.FileEvictions)
+	assert.Equal(t, int64(0), s.Bytes)
+}
+
+func TestPtreeInvalidation(t *testing.T) {
+	tables := NewSFTables()
+	oid := func(pid int64) sfgo.OID { return sfgo.OID{Hpid: pid, CreateTS: pid} }
+	exes := func(ptree []*sfgo.Process) []string {
+		var s []string
+		for _, p := range ptree {
+			s = append(s, p.Exe)
+		}
+		return s
+	}
+
+	// the child arrives before its parent
+	child := newProc(3, 2, "", "/usr/bin/cat")
+	tables.SetProc(*child.Oid, child)
+	assert.Equal(t, []string{"/usr/bin/cat"}, exes(tables.GetPtree(oid(3))))
+	for _, p := range []*sfgo.Process{newProc(1, 0, "", "/sbin/init"), newProc(2, 1, "", "/bin/bash")} {
+		tables.SetProc(*p.Oid, p)
+	}
+	ptree := tables.GetPtree(oid(3))
+	assert.Equal(t, []string{"/usr/bin/cat", "/bin/bash", "/sbin/init"}, exes(ptree))
+	assert.Equal(t, []string{"/bin/bash", "/sbin/init"}, exes(tables.GetPtree(oid(2))))
+
+	// the grandparent execs into a new binary
+	mod := newProc(1, 0, "", "/usr/lib/systemd/systemd")
+	mod.State = sfgo.SFObjectStateMODIFIED
+	tables.SetProc(*mod.Oid, mod)
+	assert.Equal(t, []string{"/usr/bin/cat", "/bin/bash", "/usr/lib/systemd/systemd"}, exes(tables.GetPtree(oid(3))))
+	assert.Equal(t, []string{"/bin/bash", "/usr/lib/systemd/systemd"}, exes(tables.GetPtree(oid(2))))
+	assert.Equal(t, "/sbin/init", ptree[2].Exe, "previously returned trees are not modified")
+
+	// unchanged trees stay cached
+	p := tables.GetPtree(oid(3))
+	assert.Same(t, &p[0], &tables.GetPtree(oid(3))[0])
+}
+
+func TestSnapshot(t *testing.T) {
+	tables := NewSFTables()
+	cont := &sfgo.ContainerIdUnion{UnionType: sfgo.ContainerIdUnionTypeEnumString, String: "c1"}
+	tables.SetPod("p1", &sfgo.Pod{Id: "p1", Labels: map[string]string{"app": "web"}})
+	tables.SetCont("c1", &sfgo.Container{Id: "c1", PodId: &sfgo.PodIdUnion{UnionType: sfgo.PodIdUnionTypeEnumString, String: "p1"}})
+	for _, p := range []*sfgo.Process{
+		newProc(1, 0, "c1", "/sbin/init"),
+		newProc(2, 1, "c1", "/bin/bash"),
+		newProc(3, 2, "c1", "/usr/bin/cat"),
+	} {
+		if p.Poid == nil {
+			p.Poid = &sfgo.PoidUnion{UnionType: 0}
+		}
+		tables.SetProc(*p.Oid, p)
+	}
+	tables.ExitProc(sfgo.OID{Hpid: 2, CreateTS: 2})
+	tables.SetFile(sfgo.FOID{1}, &sfgo.File{Oid: sfgo.FOID{1}, Path: "/etc/passwd", ContainerId: cont})
+	tables.SetFile(sfgo.FOID{2}, &sfgo.File{Oid: sfgo.FOID{2}, Path: "/etc/hosts", ContainerId: cont})
+	tables.GetFile(sfgo.FOID{1})
+
+	path := filepath.Join(t.TempDir(), "tables.sf")
+	ts := time.Now().Add(-time.Hour).Truncate(time.Second)
+	assert.NoError(t, WriteSnapshot(path, &Snapshot{Time: ts, Sources: map[string]*Entities{"node1": tables.Entities()}}))
+	s, err := ReadSnapshot(path)
+	if !assert.NoError(t, err) || !assert.Contains(t, s.Sources, "node1") {
+		return
+	}
+	assert.True(t, ts.Equal(s.Time))
+
+	restored := NewSFTablesFromConfig(Config{FileMaxSize: 1})
+	restored.Restore(s.Sources["node1"])
+	assert.Equal(t, "web", restored.GetPod("p1").Labels["app"])
+	assert.Equal(t, "p1", restored.GetCont("c1").PodId.String)
+	assert.Len(t, restored.GetPtree(sfgo.OID{Hpid: 3, CreateTS: 3}), 3)
+	assert.Equal(t, "/etc/passwd", restored.GetFile(sfgo.FOID{1}).Path, "most recently used file is kept")
+
+	// the exited parent is evicted along with its last child
+	restored.ExitProc(sfgo.OID{Hpid: 3, CreateTS: 3})
+	assert.Nil(t, restored.GetProc(sfgo.OID{Hpid: 2, CreateTS: 2}))
+	assert.NotNil(t, restored.GetProc(sfgo.OID{Hpid: 1, CreateTS: 1}))
+}
+
+func TestStats(t *testing.T) {
+	tables := NewSFTables()
+	for _, p := range []*sfgo.Process{
+		newProc(1, 0, "", "/sbin/init"),
+		newProc(2, 1, "", "/bin/bash"),
+		newProc(3, 2, "", "/usr/bin/cat"),
+	} {
+		tables.SetProc(*p.Oid, p)
+	}
+	tables.SetFile(sfgo.FOID{1}, &sfgo.File{Oid: sfgo.FOID{1}, Path: "/etc/passwd"})
+
+	assert.NotNil(t, tables.GetProc(sfgo.OID{Hpid: 1, CreateTS: 1}))
+	assert.Nil(t, tables.GetProc(sfgo.OID{Hpid: 4, CreateTS: 4}))
+	assert.True(t, tables.HasProc(sfgo.OID{Hpid: 2, CreateTS: 2}))
+	assert.NotNil(t, tables.GetFile(sfgo.FOID{1}))
+	assert.False(t, tables.HasFile(sfgo.FOID{2}))
+	assert.Nil(t, tables.GetCont("c1"))
+	tables.GetPtree(sfgo.OID{Hpid: 3, CreateTS: 3})
+	tables.GetPtree(sfgo.OID{Hpid: 1, CreateTS: 1})
+
+	s := tables.Stats()
+	assert.Equal(t, uint64(1), s.ProcHits)
+	assert.Equal(t, uint64(1), s.ProcMisses)
+	assert.Equal(t, uint64(1), s.FileHits)
+	assert.Equal(t, uint64(0), s.FileMisses)
+	assert.Equal(t, uint64(1), s.ContMisses)
+	assert.Equal(t, []int{1, 0, 1, 0, 0, 0}, s.PtreeDepths)
+	assert.Contains(t, s.String(), "processes=50.0% of 2")
+	assert.Contains(t, s.String(), "ptree depths(1:1 2:0 3-4:1 5-8:0 9-16:0 17+:0)")
+
+	d := tables.Dump()
+	assert.Len(t, d.Processes, 3)
+	assert.Equal(t, int64(1), d.Processes[0].Oid.Hpid)
+	if assert.Len(t, d.Files, 1) {
+		assert.Equal(t, "0100000000000000000000000000000000000000", d.Files[0].Oid)
+	}
+	b, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"oid":"0100000000000000000000000000000000000000"`)
+}
@@ -174,10 +174,11 @@ const (
 	JSONFormat       Format = iota // JSON schema
 	ECSFormat                      // Elastic Common Schema
 	OccurrenceFormat               // IBM Findings Occurrence
+	DOTFormat                      // Graphviz DOT (graphlets only)
 )
 
 func (s Format) String() string {
-	return [...]string{"json", "ecs", "occurrence"}[s]
+	return [...]string{"json", "ecs", "occurrence", "dot"}[s]
 }
 
 func parseFormatConfig(s string) Format {
@@ -188,6 +189,8 @@ func parseFormatConfig(s string) Format {
 		return ECSFormat
 	case OccurrenceFormat.String():
 		return OccurrenceFormat
+	case DOTFormat.String():
+		return DOTFormat
 	}
 	return JSONFormat
 }
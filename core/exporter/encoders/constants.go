@@ -33,6 +33,10 @@ const (
 	KE        = "k8s"
 	NODE      = "node"
 	META      = "meta"
+	PFLOW     = "pflow"
+	DEDUP     = "dedup"
+	AGG       = "agg"
+	ENTITY    = "entity"
 
 	BEGIN_STATE = iota
 	PROC_STATE
@@ -46,6 +50,10 @@ const (
 	KE_STATE
 	NODE_STATE
 	META_STATE
+	PFLOW_STATE
+	DEDUP_STATE
+	AGG_STATE
+	ENTITY_STATE
 )
 
 // Export schema shared attribute names.
@@ -57,5 +65,11 @@ const (
 	ID_TAG_ATTR       = "id"
 	DESC_ATTR         = "desc"
 	PRIORITY_ATTR     = "priority"
+	LEVEL_ATTR        = "level"
 	TAGS_ATTR         = "tags"
+	EXT_ATTR          = "ext"
+	EXPLAIN_ATTR      = "explain"
+	EXPR_ATTR         = "expr"
+	MACRO_ATTR        = "macro"
+	VALUES_ATTR       = "values"
 )
@@ -34,6 +34,7 @@ import (
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/commons"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/utils"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 	"github.com/tidwall/gjson"
 )
@@ -60,11 +61,13 @@ type ECSRecord struct {
 	Service      []JSONData `json:"service,omitempty"`
 	File         JSONData   `json:"file,omitempty"`
 	FileAction   JSONData   `json:"sf_file_action,omitempty"`
+	ProcFlow     JSONData   `json:"sf_proc_flow,omitempty"`
 	Network      JSONData   `json:"network,omitempty"`
 	Source       JSONData   `json:"source,omitempty"`
 	Destination  JSONData   `json:"destination,omitempty"`
 	Process      JSONData   `json:"process,omitempty"`
 	User         JSONData   `json:"user,omitempty"`
+	Rule         JSONData   `json:"rule,omitempty"`
 	Tags         []string   `json:"tags,omitempty"`
 }
 
@@ -116,8 +119,10 @@ func (t *ECSEncoder) encode(rec *engine.Record) *ECSRecord {
 			ecs.encodeOrchestrator(rec)
 			ecs.encodePod(rec)
 		}
-		ecs.Process = encodeProcess(rec)
-		ecs.User = encodeUser(rec)
+		if sfType != sfgo.TyFStr && sfType != sfgo.TyCStr && sfType != sfgo.TyPDStr {
+			ecs.Process = encodeProcess(rec)
+			ecs.User = encodeUser(rec)
+		}
 	} else {
 		ecs.encodeOrchestrator(rec)
 	}
@@ -125,14 +130,35 @@ func (t *ECSEncoder) encode(rec *engine.Record) *ECSRecord {
 	switch sfType {
 	case sfgo.TyNFStr:
 		ecs.encodeNetworkFlow(rec)
+	case sfgo.TyNEStr:
+		ecs.encodeNetworkEvent(rec)
 	case sfgo.TyFFStr:
 		ecs.encodeFileFlow(rec)
 	case sfgo.TyFEStr:
 		ecs.encodeFileEvent(rec)
 	case sfgo.TyPEStr:
 		ecs.encodeProcessEvent(rec)
+	case sfgo.TyPFStr:
+		ecs.encodeProcessFlow(rec)
 	case sfgo.TyKEStr:
 		ecs.encodeK8sEvent(rec)
+	case sfgo.TyPStr:
+		ecs.encodeEntity(rec, ECS_CAT_PROCESS)
+	case sfgo.TyFStr:
+		ecs.File = encodeFile(rec)
+		ecs.encodeEntity(rec, ECS_CAT_FILE)
+	case sfgo.TyCStr, sfgo.TyPDStr:
+		ecs.encodeEntity(rec, ECS_CAT_ORCH)
+	}
+	if n := engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(rec); n > 0 && ecs.Event != nil {
+		ecs.Event[ECS_EVENT_SFDEDUP] = JSONData{
+			ECS_SF_DEDUP_COUNT: n,
+			ECS_SF_DEDUP_FIRST: utils.ToIsoTimeStr(engine.Mapper.MapInt(engine.SF_DEDUP_FIRSTTS)(rec)),
+			ECS_SF_DEDUP_LAST:  utils.ToIsoTimeStr(engine.Mapper.MapInt(engine.SF_DEDUP_LASTTS)(rec)),
+		}
+	}
+	if n := engine.Mapper.MapInt(engine.SF_AGG_COUNT)(rec); n > 0 && ecs.Event != nil {
+		ecs.Event[ECS_EVENT_SFAGG] = JSONData{ECS_SF_AGG_COUNT: n}
 	}
 
 	// encode tags and policy information
@@ -140,14 +166,18 @@ func (t *ECSEncoder) encode(rec *engine.Record) *ECSRecord {
 	rules := rec.Ctx.GetRules()
 	if len(rules) > 0 {
 		reasons := make([]string, 0)
-		priority := int(engine.Low)
+		top := rules[0]
 		for _, r := range rules {
 			reasons = append(reasons, r.Name)
 			tags = append(tags, extracTags(r.Tags)...)
-			priority = utils.Max(priority, int(r.Priority))
+			if r.Priority.Severity() > top.Priority.Severity() {
+				top = r
+			}
 		}
 		ecs.Event[ECS_EVENT_REASON] = strings.Join(reasons, ", ")
-		ecs.Event[ECS_EVENT_SEVERITY] = priority
+		ecs.Event[ECS_EVENT_SEVERITY] = int(top.Priority.Level())
+		ecs.Event[ECS_EVENT_SFSEVERITY] = top.Priority.Severity()
+		ecs.encodeRule(top)
 	}
 	if len(tags) > 0 {
 		ecs.Tags = tags
@@ -173,9 +203,9 @@ func encodeID(rec *engine.Record) string {
 	binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.EV_PROC_OPFLAGS_INT, sfgo.SYSFLOW_SRC)))
 	h.Write(byteInt64)
 	switch t {
-	case sfgo.TyFFStr, sfgo.TyFEStr:
+	case sfgo.TyFFStr, sfgo.TyFEStr, sfgo.TyFStr:
 		h.Write([]byte(engine.Mapper.MapStr(engine.SF_FILE_OID)(rec)))
-	case sfgo.TyNFStr:
+	case sfgo.TyNFStr, sfgo.TyNEStr:
 		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_SIP_INT, sfgo.SYSFLOW_SRC)))
 		h.Write(byteInt64)
 		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.FL_NETW_SPORT_INT, sfgo.SYSFLOW_SRC)))
@@ -192,6 +222,8 @@ func encodeID(rec *engine.Record) string {
 		binary.LittleEndian.PutUint64(byteInt64, uint64(rec.GetInt(sfgo.K8SE_KIND_INT, sfgo.SYSFLOW_SRC)))
 		h.Write(byteInt64)
 		h.Write([]byte(engine.Mapper.MapStr(engine.SF_K8SE_MESSAGE)(rec)))
+	case sfgo.TyPDStr:
+		h.Write([]byte(engine.Mapper.MapStr(engine.SF_POD_ID)(rec)))
 	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
@@ -232,9 +264,63 @@ func (ecs *ECSRecord) encodeNetworkFlow(rec *engine.Record) {
 		ECS_ENDPOINT_BYTES:   rbytes,
 		ECS_ENDPOINT_PACKETS: rops,
 	}
+	if rec.HasSource(sfgo.NETWORK_SRC) {
+		if v := engine.Mapper.MapStr(engine.EXT_NET_SOURCE_HOST_NAME_STR)(rec); v != sfgo.Zeros.String {
+			ecs.Source[ECS_ENDPOINT_DOMAIN] = v
+		}
+		if v := engine.Mapper.MapStr(engine.EXT_NET_DEST_HOST_NAME_STR)(rec); v != sfgo.Zeros.String {
+			ecs.Destination[ECS_ENDPOINT_DOMAIN] = v
+		}
+	}
 	ecs.Event = encodeEvent(rec, ECS_CAT_NETWORK, ECS_TYPE_CONNECTION, ECS_CAT_NETWORK+"-"+ECS_ACTION_TRAFFIC)
 }
 
+// encodeNetworkEvent populates the ECS representatiom of a NE record
+func (ecs *ECSRecord) encodeNetworkEvent(rec *engine.Record) {
+	opFlags := rec.GetInt(layout.EV_NET_OPFLAGS_INT, sfgo.SYSFLOW_SRC)
+	sip := engine.Mapper.MapStr(engine.SF_NET_SIP)(rec)
+	dip := engine.Mapper.MapStr(engine.SF_NET_DIP)(rec)
+	sport := engine.Mapper.MapInt(engine.SF_NET_SPORT)(rec)
+	dport := engine.Mapper.MapInt(engine.SF_NET_DPORT)(rec)
+	proto := engine.Mapper.MapInt(engine.SF_NET_PROTO)(rec)
+
+	cid, _ := gommunityid.GetCommunityIDByVersion(1, 0)
+	ft := gommunityid.MakeFlowTuple(net.ParseIP(sip), net.ParseIP(dip), uint16(sport), uint16(dport), uint8(proto))
+
+	ecs.Network = JSONData{
+		ECS_NET_CID:   cid.CalcBase64(ft),
+		ECS_NET_IANA:  strconv.FormatInt(proto, 10),
+		ECS_NET_PROTO: sfgo.GetProto(proto),
+	}
+	ecs.Source = JSONData{
+		ECS_ENDPOINT_IP:   sip,
+		ECS_ENDPOINT_PORT: sport,
+		ECS_ENDPOINT_ADDR: sip,
+	}
+	ecs.Destination = JSONData{
+		ECS_ENDPOINT_IP:   dip,
+		ECS_ENDPOINT_PORT: dport,
+		ECS_ENDPOINT_ADDR: dip,
+	}
+	if rec.HasSource(sfgo.NETWORK_SRC) {
+		if v := engine.Mapper.MapStr(engine.EXT_NET_SOURCE_HOST_NAME_STR)(rec); v != sfgo.Zeros.String {
+			ecs.Source[ECS_ENDPOINT_DOMAIN] = v
+		}
+		if v := engine.Mapper.MapStr(engine.EXT_NET_DEST_HOST_NAME_STR)(rec); v != sfgo.Zeros.String {
+			ecs.Destination[ECS_ENDPOINT_DOMAIN] = v
+		}
+	}
+	category := ECS_CAT_NETWORK
+	eventType := ECS_TYPE_CONNECTION
+	action := category + "-" + eventType
+	if opFlags&sfgo.OP_ACCEPT == sfgo.OP_ACCEPT {
+		action = action + "-" + ECS_ACTION_ACCEPT
+	} else if opFlags&sfgo.OP_CONNECT == sfgo.OP_CONNECT {
+		action = action + "-" + ECS_ACTION_CONNECT
+	}
+	ecs.Event = encodeEvent(rec, category, eventType, action)
+}
+
 // encodeFileFlow populates the ECS representatiom of a FF record
 func (ecs *ECSRecord) encodeFileFlow(rec *engine.Record) {
 	opFlags := rec.GetInt(sfgo.EV_PROC_OPFLAGS_INT, sfgo.SYSFLOW_SRC)
@@ -319,6 +405,35 @@ func (ecs *ECSRecord) encodeProcessEvent(rec *engine.Record) {
 	ecs.Event = encodeEvent(rec, category, eventType, action)
 }
 
+// encodeProcessFlow populates the ECS representatiom of a PF record
+func (ecs *ECSRecord) encodeProcessFlow(rec *engine.Record) {
+	category := ECS_CAT_PROCESS
+	eventType := ECS_TYPE_INFO
+	action := category + "-" + eventType
+	ecs.Event = encodeEvent(rec, category, eventType, action)
+	ecs.ProcFlow = JSONData{
+		ECS_SF_PF_TCLONED:     engine.Mapper.MapInt(engine.SF_PF_THREADSCLONED)(rec),
+		ECS_SF_PF_TEXITED:     engine.Mapper.MapInt(engine.SF_PF_THREADSEXITED)(rec),
+		ECS_SF_PF_CLONEERRORS: engine.Mapper.MapInt(engine.SF_PF_CLONEERRORS)(rec),
+	}
+}
+
+// encodeEntity populates the ECS representation of a process, file, container or pod entity record.
+func (ecs *ECSRecord) encodeEntity(rec *engine.Record, category string) {
+	eventType := ECS_TYPE_INFO
+	state := engine.Mapper.MapStr(engine.SF_ENTITY_STATE)(rec)
+	switch state {
+	case sfgo.SFObjectStateCREATED.String():
+		eventType = ECS_TYPE_CREATE
+	case sfgo.SFObjectStateMODIFIED.String():
+		eventType = ECS_TYPE_CHANGE
+	}
+	ecs.Event = encodeEvent(rec, category, eventType, category+"-"+eventType)
+	if state != sfgo.Zeros.String {
+		ecs.Event[ECS_EVENT_SFSTATE] = state
+	}
+}
+
 func k8sActionToEventType(rec *engine.Record) string {
 	eventType := ECS_TYPE_INFO
 	am := engine.Mapper.Mappers[engine.SF_K8SE_ACTION]
@@ -356,6 +471,15 @@ func (ecs *ECSRecord) encodeK8sEvent(rec *engine.Record) {
 	}
 }
 
+// encodeRule creates an ECS rule field from the highest priority rule matching a record.
+func (ecs *ECSRecord) encodeRule(r engine.Rule) {
+	ecs.Rule = JSONData{
+		ECS_RULE_NAME:        r.Name,
+		ECS_RULE_DESC:        r.Desc,
+		ECS_RULE_SF_PRIORITY: r.Priority.String(),
+	}
+}
+
 // encodeOrchestrator creates an ECS orchestrator field.
 func (ecs *ECSRecord) encodeOrchestrator(rec *engine.Record) {
 	ecs.Orchestrator = JSONData{
@@ -488,9 +612,75 @@ func encodeProcess(rec *engine.Record) JSONData {
 		ECS_PROC_NAME:    path.Base(pexe),
 	}
 	process[ECS_PROC_PARENT] = parent
+	if rec.HasSource(sfgo.PROCESS_SRC) {
+		encodeCodeIdentity(rec, process, procIdentity)
+		if wd := rec.GetStr(sfgo.PROC_CURR_DIRECTORY_STR, sfgo.PROCESS_SRC); wd != sfgo.Zeros.String {
+			process[ECS_PROC_WORKDIR] = wd
+		}
+	}
+	if rec.HasSource(sfgo.TARG_PROC_SRC) {
+		process[ECS_PROC_SF_TARGET] = encodeTargetProcess(rec)
+	}
 	return process
 }
 
+// encodeTargetProcess creates an ECS process field for the target process of a record.
+func encodeTargetProcess(rec *engine.Record) JSONData {
+	exe := engine.Mapper.MapStr(engine.EXT_TARG_PROC_EXE_STR)(rec)
+	target := JSONData{
+		ECS_PROC_EXE:   exe,
+		ECS_PROC_ARGS:  engine.Mapper.MapStr(engine.EXT_TARG_PROC_EXEARGS_STR)(rec),
+		ECS_PROC_PID:   engine.Mapper.MapInt(engine.EXT_TARG_PROC_OID_HPID_INT)(rec),
+		ECS_PROC_START: utils.ToIsoTimeStr(engine.Mapper.MapInt(engine.EXT_TARG_PROC_OID_CREATETS_INT)(rec)),
+		ECS_PROC_NAME:  path.Base(exe),
+	}
+	encodeCodeIdentity(rec, target, targProcIdentity)
+	return target
+}
+
+// codeIdentity defines the extended attributes identifying the code of a process or file.
+type codeIdentity struct {
+	sha1, md5, sha256, imphash, signature, sigstatus, signed string
+}
+
+var (
+	procIdentity = codeIdentity{engine.EXT_PROC_SHA1_HASH_STR, engine.EXT_PROC_MD5_HASH_STR, engine.EXT_PROC_SHA256_HASH_STR,
+		engine.EXT_PROC_IMP_HASH_STR, engine.EXT_PROC_SIGNATURE_STR, engine.EXT_PROC_SIGNATURE_STATUS_STR, engine.EXT_PROC_SIGNED_INT}
+	fileIdentity = codeIdentity{engine.EXT_FILE_SHA1_HASH_STR, engine.EXT_FILE_MD5_HASH_STR, engine.EXT_FILE_SHA256_HASH_STR,
+		engine.EXT_FILE_IMP_HASH_STR, engine.EXT_FILE_SIGNATURE_STR, engine.EXT_FILE_SIGNATURE_STATUS_STR, engine.EXT_FILE_SIGNED_INT}
+	targProcIdentity = codeIdentity{engine.EXT_TARG_PROC_SHA1_HASH_STR, engine.EXT_TARG_PROC_MD5_HASH_STR, engine.EXT_TARG_PROC_SHA256_HASH_STR,
+		engine.EXT_TARG_PROC_IMP_HASH_STR, engine.EXT_TARG_PROC_SIGNATURE_STR, engine.EXT_TARG_PROC_SIGNATURE_STATUS_STR, engine.EXT_TARG_PROC_SIGNED_INT}
+)
+
+// encodeCodeIdentity adds ECS hash, code signature and PE fields to an ECS process or file field.
+func encodeCodeIdentity(rec *engine.Record, data JSONData, id codeIdentity) {
+	hash := JSONData{}
+	for k, attr := range map[string]string{ECS_HASH_SHA1: id.sha1, ECS_HASH_MD5: id.md5, ECS_HASH_SHA256: id.sha256} {
+		if v := engine.Mapper.MapStr(attr)(rec); v != sfgo.Zeros.String {
+			hash[k] = v
+		}
+	}
+	if len(hash) > 0 {
+		data[ECS_HASH] = hash
+	}
+	if v := engine.Mapper.MapStr(id.imphash)(rec); v != sfgo.Zeros.String {
+		data[ECS_PE] = JSONData{ECS_PE_IMPHASH: v}
+	}
+	status := engine.Mapper.MapStr(id.sigstatus)(rec)
+	subject := engine.Mapper.MapStr(id.signature)(rec)
+	signed := engine.Mapper.MapInt(id.signed)(rec) == 1
+	if signed || status != sfgo.Zeros.String || subject != sfgo.Zeros.String {
+		sig := JSONData{ECS_CODESIG_EXISTS: signed}
+		if status != sfgo.Zeros.String {
+			sig[ECS_CODESIG_STATUS] = status
+		}
+		if subject != sfgo.Zeros.String {
+			sig[ECS_CODESIG_SUBJECT] = subject
+		}
+		data[ECS_CODESIG] = sig
+	}
+}
+
 // encodeEvent creates the central ECS event field and sets the classification attributes
 func encodeEvent(rec *engine.Record, category string, eventType string, action string) JSONData {
 	start := engine.Mapper.MapInt(engine.SF_TS)(rec)
@@ -517,7 +707,7 @@ func encodeEvent(rec *engine.Record, category string, eventType string, action s
 		event[ECS_EVENT_KIND] = ECS_KIND_EVENT
 	}
 
-	if sfType == sfgo.TyPEStr || sfType == sfgo.TyFEStr {
+	if sfType == sfgo.TyPEStr || sfType == sfgo.TyFEStr || sfType == sfgo.TyNEStr {
 		event[ECS_EVENT_SFRET] = sfRet
 	}
 	return event
@@ -554,6 +744,9 @@ func encodeFile(rec *engine.Record) JSONData {
 			file[ECS_FILE_PATH] = fpath
 		}
 	}
+	if rec.HasSource(sfgo.FILE_SRC) {
+		encodeCodeIdentity(rec, file, fileIdentity)
+	}
 
 	return file
 }
@@ -47,8 +47,13 @@ const (
 	ECS_EVENT_DURATION = "duration"
 	ECS_EVENT_SFTYPE   = "sf_type"
 	ECS_EVENT_SFRET    = "sf_ret"
+	ECS_EVENT_SFDEDUP  = "sf_dedup"
+	ECS_EVENT_SFAGG    = "sf_agg"
+	ECS_EVENT_SFSTATE  = "sf_state"
 	ECS_EVENT_REASON   = "reason"
 	ECS_EVENT_SEVERITY = "severity"
+	// ECS_EVENT_SFSEVERITY is the rule priority on the full Falco scale, from debug (0) to emergency (7).
+	ECS_EVENT_SFSEVERITY = "sf_severity"
 
 	ECS_FILE_DIR    = "directory"
 	ECS_FILE_NAME   = "name"
@@ -66,12 +71,21 @@ const (
 	ECS_HASH_SHA1   = "sha1"
 	ECS_HASH_SHA256 = "sha256"
 
+	// used in proc and file fields
+	ECS_CODESIG         = "code_signature"
+	ECS_CODESIG_EXISTS  = "exists"
+	ECS_CODESIG_STATUS  = "status"
+	ECS_CODESIG_SUBJECT = "subject_name"
+	ECS_PE              = "pe"
+	ECS_PE_IMPHASH      = "imphash"
+
 	ECS_NET_BYTES = "bytes"
 	ECS_NET_CID   = "community_id"
 	ECS_NET_IANA  = "iana_number"
 	ECS_NET_PROTO = "protocol"
 
 	// used in source and destination fields
+	ECS_ENDPOINT_DOMAIN  = "domain"
 	ECS_ENDPOINT_ADDR    = "address"
 	ECS_ENDPOINT_BYTES   = "bytes"
 	ECS_ENDPOINT_IP      = "ip"
@@ -103,12 +117,24 @@ const (
 	ECS_PROC_THREAD     = "thread"
 	ECS_PROC_TID        = "id"
 	ECS_PROC_START      = "start"
+	ECS_PROC_WORKDIR    = "working_directory"
+	ECS_PROC_SF_TARGET  = "sf_target"
 
 	ECS_SF_FA_RBYTES = "bytes_read"
 	ECS_SF_FA_ROPS   = "read_ops"
 	ECS_SF_FA_WBYTES = "bytes_written"
 	ECS_SF_FA_WOPS   = "write_ops"
 
+	ECS_SF_PF_TCLONED     = "threads_cloned"
+	ECS_SF_PF_TEXITED     = "threads_exited"
+	ECS_SF_PF_CLONEERRORS = "clone_errors"
+
+	ECS_SF_DEDUP_COUNT = "count"
+	ECS_SF_DEDUP_FIRST = "first"
+	ECS_SF_DEDUP_LAST  = "last"
+
+	ECS_SF_AGG_COUNT = "count"
+
 	ECS_SERVICE_ID         = "id"
 	ECS_SERVICE_NAME       = "name"
 	ECS_SERVICE_NAMESPACE  = "namespace"
@@ -125,6 +151,10 @@ const (
 	ECS_THREAT_FRAMEWORK    = "framework"
 	ECS_THREAT_TECHNIQUE_ID = "id"
 
+	ECS_RULE_NAME        = "name"
+	ECS_RULE_DESC        = "description"
+	ECS_RULE_SF_PRIORITY = "sf_priority"
+
 	ECS_TAGS = "tags"
 )
 
@@ -168,4 +198,6 @@ const (
 	ECS_ACTION_LINK    = "link"
 	ECS_ACTION_RENAME  = "rename"
 	ECS_ACTION_TRAFFIC = "connection-traffic"
+	ECS_ACTION_ACCEPT  = "accept"
+	ECS_ACTION_CONNECT = "connect"
 )
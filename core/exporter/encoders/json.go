@@ -36,21 +36,23 @@ import (
 
 // JSONEncoder is a JSON encoder.
 type JSONEncoder struct {
-	config     commons.Config
-	fieldCache []*engine.FieldValue
-	writer     *jwriter.Writer
-	buf        []byte
-	batch      []commons.EncodedData
+	config        commons.Config
+	fieldCache    []*engine.FieldValue
+	extFieldCache []*engine.FieldValue
+	writer        *jwriter.Writer
+	buf           []byte
+	batch         []commons.EncodedData
 }
 
 // NewJSONEncoder instantiates a JSON encoder.
 func NewJSONEncoder(config commons.Config) Encoder {
 	return &JSONEncoder{
-		fieldCache: engine.FieldValues,
-		config:     config,
-		writer:     &jwriter.Writer{},
-		buf:        make([]byte, 0, BUFFER_SIZE),
-		batch:      make([]commons.EncodedData, 0, config.EventBuffer)}
+		fieldCache:    engine.FieldValues,
+		extFieldCache: engine.ExtFieldValues,
+		config:        config,
+		writer:        &jwriter.Writer{},
+		buf:           make([]byte, 0, BUFFER_SIZE),
+		batch:         make([]commons.EncodedData, 0, config.EventBuffer)}
 }
 
 // Register registers the encoder to the codecs cache.
@@ -85,7 +87,11 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 	ctExists := !reflect.ValueOf(ct).IsZero()
 	pd := engine.Mapper.MapStr(engine.SF_POD_ID)(rec)
 	pdExists := !reflect.ValueOf(pd).IsZero()
-	existed := true
+	dedupExists := engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(rec) > 0
+	aggExists := engine.Mapper.MapInt(engine.SF_AGG_COUNT)(rec) > 0
+	entityExists := engine.Mapper.MapStr(engine.SF_ENTITY_STATE)(rec) != sfgo.Zeros.String
+	procExists := sftype != sfgo.TyFStr && sftype != sfgo.TyCStr && sftype != sfgo.TyPDStr
+	existed := false
 
 	for _, fv := range t.fieldCache {
 		numFields := len(fv.FieldSects)
@@ -141,28 +147,28 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 			switch fv.Entry.Section {
 			case engine.SectProc:
 				if state != PROC_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
+					if procExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
+						existed = true
+						t.writeSectionBegin(PROC)
+						t.writeAttribute(fv, 2, rec)
 					}
-					existed = true
-					t.writeSectionBegin(PROC)
-					t.writeAttribute(fv, 2, rec)
 					state = PROC_STATE
-				} else {
+				} else if procExists {
 					t.writer.RawByte(COMMA)
 					t.writeAttribute(fv, 2, rec)
 				}
 			case engine.SectPProc:
 				if state != PPROC_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
 					if pprocExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						existed = true
 						t.writeSectionBegin(PPROC)
 						t.writeAttribute(fv, 2, rec)
-					} else {
-						existed = false
 					}
 					state = PPROC_STATE
 				} else if pprocExists {
@@ -171,66 +177,118 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 				}
 			case engine.SectNet:
 				if state != NET_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
-					if sftype == sfgo.TyNFStr {
+					if sftype == sfgo.TyNFStr || sftype == sfgo.TyNEStr {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						t.writeSectionBegin(NET)
 						t.writeAttribute(fv, 2, rec)
 						existed = true
-					} else {
-						existed = false
 					}
 					state = NET_STATE
-				} else if sftype == sfgo.TyNFStr {
+				} else if sftype == sfgo.TyNFStr || sftype == sfgo.TyNEStr {
 					t.writer.RawByte(COMMA)
 					t.writeAttribute(fv, 2, rec)
 				}
 			case engine.SectFile:
 				if state != FILE_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
-					if sftype == sfgo.TyFFStr || sftype == sfgo.TyFEStr {
+					if sftype == sfgo.TyFFStr || sftype == sfgo.TyFEStr || sftype == sfgo.TyFStr {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						t.writeSectionBegin(FILEF)
 						t.writeAttribute(fv, 2, rec)
 						existed = true
-					} else {
-						existed = false
 					}
 					state = FILE_STATE
-				} else if sftype == sfgo.TyFFStr || sftype == sfgo.TyFEStr {
+				} else if sftype == sfgo.TyFFStr || sftype == sfgo.TyFEStr || sftype == sfgo.TyFStr {
 					t.writer.RawByte(COMMA)
 					t.writeAttribute(fv, 2, rec)
 				}
 			case engine.SectFlow:
 				if state != FLOW_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
 					if sftype == sfgo.TyFFStr || sftype == sfgo.TyNFStr {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						t.writeSectionBegin(FLOW)
 						t.writeAttribute(fv, 2, rec)
 						existed = true
-					} else {
-						existed = false
 					}
 					state = FLOW_STATE
 				} else if sftype == sfgo.TyFFStr || sftype == sfgo.TyNFStr {
 					t.writer.RawByte(COMMA)
 					t.writeAttribute(fv, 2, rec)
 				}
+			case engine.SectPFlow:
+				if state != PFLOW_STATE {
+					if sftype == sfgo.TyPFStr {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
+						t.writeSectionBegin(PFLOW)
+						t.writeAttribute(fv, 2, rec)
+						existed = true
+					}
+					state = PFLOW_STATE
+				} else if sftype == sfgo.TyPFStr {
+					t.writer.RawByte(COMMA)
+					t.writeAttribute(fv, 2, rec)
+				}
+			case engine.SectDedup:
+				if state != DEDUP_STATE {
+					if dedupExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
+						t.writeSectionBegin(DEDUP)
+						t.writeAttribute(fv, 2, rec)
+						existed = true
+					}
+					state = DEDUP_STATE
+				} else if dedupExists {
+					t.writer.RawByte(COMMA)
+					t.writeAttribute(fv, 2, rec)
+				}
+			case engine.SectAgg:
+				if state != AGG_STATE {
+					if aggExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
+						t.writeSectionBegin(AGG)
+						t.writeAttribute(fv, 2, rec)
+						existed = true
+					}
+					state = AGG_STATE
+				} else if aggExists {
+					t.writer.RawByte(COMMA)
+					t.writeAttribute(fv, 2, rec)
+				}
+			case engine.SectEntity:
+				if state != ENTITY_STATE {
+					if entityExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
+						t.writeSectionBegin(ENTITY)
+						t.writeAttribute(fv, 2, rec)
+						existed = true
+					}
+					state = ENTITY_STATE
+				} else if entityExists {
+					t.writer.RawByte(COMMA)
+					t.writeAttribute(fv, 2, rec)
+				}
 			case engine.SectCont:
 				if state != CONT_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
 					if ctExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						t.writeSectionBegin(CONTAINER)
 						t.writeAttribute(fv, 2, rec)
 						existed = true
-					} else {
-						existed = false
 					}
 					state = CONT_STATE
 				} else if ctExists {
@@ -239,15 +297,13 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 				}
 			case engine.SectPod:
 				if state != POD_STATE {
-					if state != BEGIN_STATE && existed {
-						t.writer.RawString(END_CURLY_COMMA)
-					}
 					if pdExists {
+						if state != BEGIN_STATE && existed {
+							t.writer.RawString(END_CURLY_COMMA)
+						}
 						t.writeSectionBegin(POD)
 						t.writeAttribute(fv, 2, rec)
 						existed = true
-					} else {
-						existed = false
 					}
 					state = POD_STATE
 				} else if pdExists {
@@ -285,8 +341,12 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 	}
 	t.writer.RawByte(END_CURLY)
 
+	// Encode extended attributes
+	t.encodeExt(rec)
+
 	// Encode policies
 	numRules := len(rec.Ctx.GetRules())
+	exps := rec.Ctx.GetExplanations()
 	rtags := make([]string, 0)
 	if numRules > 0 {
 		t.writer.RawString(POLICIES)
@@ -296,7 +356,12 @@ func (t *JSONEncoder) encode(rec *engine.Record) (commons.EncodedData, error) {
 			t.writer.RawString(DESC)
 			t.writer.String(r.Desc)
 			t.writer.RawString(PRIORITY)
-			t.writer.Int64(int64(r.Priority))
+			t.writer.Int64(int64(r.Priority.Level()))
+			t.writer.RawString(LEVEL)
+			t.writer.String(r.Priority.String())
+			if num < len(exps) && exps[num].Rule == r.Name {
+				t.encodeExplanations(exps[num].Explanations)
+			}
 			t.writer.RawByte(END_CURLY)
 			if num < (numRules - 1) {
 				t.writer.RawByte(COMMA)
@@ -354,6 +419,63 @@ func (t *JSONEncoder) writeAttribute(fv *engine.FieldValue, fieldID int, rec *en
 	MapJSON(fv, t.writer, rec)
 }
 
+// encodeExt encodes the extended attributes of the data sources contained in a record.
+func (t *JSONEncoder) encodeExt(rec *engine.Record) {
+	section := ""
+	for _, fv := range t.extFieldCache {
+		if !rec.HasSource(fv.Entry.Source) {
+			continue
+		}
+		if section == "" {
+			t.writer.RawString(EXT)
+		}
+		if fv.FieldSects[1] != section {
+			if section != "" {
+				t.writer.RawString(END_CURLY_COMMA)
+			}
+			section = fv.FieldSects[1]
+			t.writeSectionBegin(section)
+		} else {
+			t.writer.RawByte(COMMA)
+		}
+		t.writeAttribute(fv, 2, rec)
+	}
+	if section != "" {
+		t.writer.RawByte(END_CURLY)
+		t.writer.RawByte(END_CURLY)
+	}
+}
+
+// encodeExplanations encodes the predicates that evaluated true when a rule matched a record.
+func (t *JSONEncoder) encodeExplanations(exps []engine.Explanation) {
+	t.writer.RawString(EXPLAIN)
+	for i, e := range exps {
+		if i > 0 {
+			t.writer.RawByte(COMMA)
+		}
+		t.writer.RawString(EXPR)
+		t.writer.String(e.Expr)
+		if e.Macro != "" {
+			t.writer.RawString(MACRO)
+			t.writer.String(e.Macro)
+		}
+		if len(e.Operands) > 0 {
+			t.writer.RawString(VALUES)
+			for j, o := range e.Operands {
+				if j > 0 {
+					t.writer.RawByte(COMMA)
+				}
+				t.writer.String(o.Attr)
+				t.writer.RawByte(COLON)
+				t.writer.String(o.Value)
+			}
+			t.writer.RawByte(END_CURLY)
+		}
+		t.writer.RawByte(END_CURLY)
+	}
+	t.writer.RawByte(END_SQUARE)
+}
+
 func (t *JSONEncoder) writeSectionBegin(section string) {
 	t.writer.RawByte(DOUBLE_QUOTE)
 	t.writer.RawString(section)
@@ -524,7 +646,7 @@ func MapJSON(fv *engine.FieldValue, writer *jwriter.Writer, r *engine.Record) {
 				return
 			case sfgo.FL_FILE_OPENFLAGS_INT:
 				recType := r.GetInt(sfgo.SF_REC_TYPE, fv.Entry.Source)
-				if recType == sfgo.NET_FLOW {
+				if recType == sfgo.NET_FLOW || recType == sfgo.NET_EVT {
 					mapIPs(fv, writer, r)
 					return
 				}
@@ -669,6 +791,8 @@ func setCachedValueToJSON(r *engine.Record, ID sfgo.OID, attr engine.RecAttribut
 				}
 			}
 			writer.RawByte(END_SQUARE)
+		case engine.ProcADepth:
+			writer.Int64(int64(len(ptree) - 1))
 		}
 	}
 }
@@ -31,6 +31,7 @@ const (
 	GROUP_ID          = "{\"" + GROUP_ID_ATTR + "\":\""
 	COMMA             = ','
 	DOUBLE_QUOTE      = '"'
+	COLON             = ':'
 	QUOTE_COLON       = "\":"
 	QUOTE_COLON_CURLY = "\":{"
 	BEGIN_CURLY       = '{'
@@ -43,7 +44,13 @@ const (
 	ID_TAG            = "{\"" + ID_TAG_ATTR + "\":"
 	DESC              = ",\"" + DESC_ATTR + "\":"
 	PRIORITY          = ",\"" + PRIORITY_ATTR + "\":"
+	LEVEL             = ",\"" + LEVEL_ATTR + "\":"
 	TAGS              = ",\"" + TAGS_ATTR + "\":["
+	EXT               = ",\"" + EXT_ATTR + "\":{"
+	EXPLAIN           = ",\"" + EXPLAIN_ATTR + "\":["
+	EXPR              = "{\"" + EXPR_ATTR + "\":"
+	MACRO             = ",\"" + MACRO_ATTR + "\":"
+	VALUES            = ",\"" + VALUES_ATTR + "\":{"
 	PERIOD            = '.'
 	EMPTY_STRING      = "\"\""
 )
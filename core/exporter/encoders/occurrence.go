@@ -314,7 +314,7 @@ func (oe *OccurrenceEncoder) addEvent(r *engine.Record) (e *Event, ep *EventPool
 	ep.Events = append(ep.Events, e)
 	for _, r := range r.Ctx.GetRules() {
 		ep.RuleTypes.Add(r.Name)
-		ep.TopSeverity = Severity(utils.Max(int(ep.TopSeverity), int(r.Priority)))
+		ep.TopSeverity = Severity(utils.Max(int(ep.TopSeverity), int(r.Priority.Level())))
 	}
 
 	// check if a semantically equivalent record has been seen before
@@ -376,7 +376,7 @@ func (oe *OccurrenceEncoder) createOccurrence(e *Event, ep *EventPool) *Occurren
 	}
 	rnames, tags, severity := oe.summarizePolicy(e.Record)
 	oc.Severity = severity
-	polStr := fmt.Sprintf(policiesStrFmt, strings.Join(rnames, listSep))
+	polStr := fmt.Sprintf(policiesStrFmt, strings.Join(oe.labelPolicies(e.Record), listSep))
 	tagsStr := fmt.Sprintf(tagsStrFmt, strings.Join(tags, listSep))
 	var detStr string
 	switch e.Record.GetInt(sfgo.SF_REC_TYPE, sfgo.SYSFLOW_SRC) {
@@ -392,7 +392,7 @@ func (oe *OccurrenceEncoder) createOccurrence(e *Event, ep *EventPool) *Occurren
 		proc := engine.Mapper.MapStr(engine.SF_PROC_CMDLINE)(e.Record)
 		path := oe.formatResource(e.Record)
 		detStr = fmt.Sprintf(ffStrFmt, proc, path)
-	case sfgo.NET_FLOW:
+	case sfgo.NET_FLOW, sfgo.NET_EVT:
 		proc := engine.Mapper.MapStr(engine.SF_PROC_CMDLINE)(e.Record)
 		conn := oe.formatResource(e.Record)
 		detStr = fmt.Sprintf(nfStrFmt, proc, conn)
@@ -418,7 +418,7 @@ func (oe *OccurrenceEncoder) summarizePolicy(r *engine.Record) (rnames []string,
 	tags = append(tags, r.Ctx.GetTags()...)
 	for _, r := range r.Ctx.GetRules() {
 		rnames = append(rnames, r.Name)
-		severity = Severity(utils.Max(int(severity), int(r.Priority)))
+		severity = Severity(utils.Max(int(severity), int(r.Priority.Level())))
 		for _, tag := range r.Tags {
 			switch tag := tag.(type) {
 			case []string:
@@ -431,12 +431,20 @@ func (oe *OccurrenceEncoder) summarizePolicy(r *engine.Record) (rnames []string,
 	return
 }
 
+// labelPolicies labels the names of rules applied to a record with their original priority levels.
+func (oe *OccurrenceEncoder) labelPolicies(r *engine.Record) (labels []string) {
+	for _, r := range r.Ctx.GetRules() {
+		labels = append(labels, fmt.Sprintf(ruleStrFmt, r.Name, r.Priority.String()))
+	}
+	return
+}
+
 // encodeEvent maps a record into an event that can be associated with an occurrence.
 func (oe *OccurrenceEncoder) encodeEvent(r *engine.Record) *Event {
-	rnames, tags, severity := oe.summarizePolicy(r)
+	_, tags, severity := oe.summarizePolicy(r)
 	e := &Event{Record: r, Event: event.NewEvent()}
 	e.Ts = engine.Mapper.MapInt(engine.SF_TS)(r)
-	e.Description = strings.Join(rnames, listSep)
+	e.Description = strings.Join(oe.labelPolicies(r), listSep)
 	e.Severity = severity.String()
 	e.ClusterID = oe.config.ClusterID
 	e.NodeID = engine.Mapper.MapStr(engine.SF_NODE_ID)(r)
@@ -459,7 +467,7 @@ func (oe *OccurrenceEncoder) formatResource(r *engine.Record) (res string) {
 	switch r.GetInt(sfgo.SF_REC_TYPE, sfgo.SYSFLOW_SRC) {
 	case sfgo.FILE_EVT, sfgo.FILE_FLOW:
 		return engine.Mapper.MapStr(engine.SF_FILE_PATH)(r)
-	case sfgo.NET_FLOW:
+	case sfgo.NET_FLOW, sfgo.NET_EVT:
 		sip := engine.Mapper.MapStr(engine.SF_NET_SIP)(r)
 		sport := engine.Mapper.MapInt(engine.SF_NET_SPORT)(r)
 		dip := engine.Mapper.MapStr(engine.SF_NET_DIP)(r)
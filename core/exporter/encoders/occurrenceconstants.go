@@ -36,6 +36,7 @@ const (
 	defaultShortDescr = "telemetry event"
 
 	policiesStrFmt = "<b>Policies</b><br>%s"
+	ruleStrFmt     = "%s (%s)"
 	tagsStrFmt     = "<b>Tags</b><br>%s"
 	detailsStrFmt  = "%s<br><br>%s<br><br>%s"
 	noteIDStrFmt   = "%s-%d"
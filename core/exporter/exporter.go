@@ -21,6 +21,7 @@
 package exporter
 
 import (
+	"encoding/json"
 	"errors"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/commons"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/encoders"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter/transports"
+	"github.com/sysflow-telemetry/sf-processor/core/graphlet"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 )
 
@@ -97,10 +99,10 @@ func (s *Exporter) Init(conf map[string]interface{}) error {
 		return err
 	}
 
-	// initialize encoder
+	// initialize encoder (graphlets are encoded by the exporter itself)
 	if createCodec, ok := codecs[s.config.Format]; ok {
 		s.encoder = createCodec(s.config)
-	} else {
+	} else if s.config.Format != commons.DOTFormat {
 		return errors.New("Unable to find encoder for " + s.config.Format.String())
 	}
 
@@ -132,9 +134,19 @@ func (s *Exporter) Process(ch []interface{}, wg *sync.WaitGroup) {
 		logger.Error.Println("Exporter only supports a single input channel at this time")
 		return
 	}
+	if gc, ok := ch[0].(*graphlet.Channel); ok {
+		s.processGraphlets(gc, wg)
+		return
+	}
 	cha := ch[0].(*engine.RecordChannel)
 	record := cha.In
 	defer wg.Done()
+	if s.encoder == nil {
+		logger.Error.Printf("Exporter format %s is only supported for graphlets", s.config.Format.String())
+		for range record {
+		}
+		return
+	}
 
 	maxIdle := 1 * time.Second
 	ticker := time.NewTicker(maxIdle)
@@ -176,6 +188,27 @@ RecLoop:
 	}
 }
 
+// processGraphlets exports the graphlets of a graphlet channel, one document per graphlet.
+func (s *Exporter) processGraphlets(ch *graphlet.Channel, wg *sync.WaitGroup) {
+	defer wg.Done()
+	logger.Trace.Printf("Starting graphlet exporter in mode %s with channel capacity %d", s.config.Transport.String(), cap(ch.In))
+	for g := range ch.In {
+		var data commons.EncodedData
+		if s.config.Format == commons.DOTFormat {
+			data = g.DOT()
+		} else if buf, err := json.Marshal(g); err == nil {
+			data = buf
+		} else {
+			logger.Error.Println(err)
+			continue
+		}
+		if err := s.transport.Export([]commons.EncodedData{data}); err != nil {
+			logger.Error.Println(err)
+		}
+	}
+	logger.Trace.Println("Channel closed. Shutting down.")
+}
+
 func (s *Exporter) process() error {
 	data, err := s.encoder.Encode(s.recs)
 	if err != nil {
@@ -198,6 +231,8 @@ func (s *Exporter) SetOutChan(ch []interface{}) {}
 // Cleanup tears down plugin resources.
 func (s *Exporter) Cleanup() {
 	logger.Trace.Println("Exiting ", pluginName)
-	s.encoder.Cleanup()
+	if s.encoder != nil {
+		s.encoder.Cleanup()
+	}
 	s.transport.Cleanup()
 }
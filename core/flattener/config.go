@@ -21,29 +21,67 @@
 package flattener
 
 import (
+	"math"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 )
 
 // Configuration keys.
 const (
-	FilterOnOffKey  string = "filter.enabled"
-	FilterMaxAgeKey string = "filter.maxage"
+	FilterOnOffKey      string = "filter.enabled"
+	FilterMaxAgeKey     string = "filter.maxage"
+	ExtOnOffKey         string = "ext.enabled"
+	EntityOnOffKey      string = "entity.enabled"
+	FilterFieldsKey     string = "filter.fields"
+	FilterMaxSizeKey    string = "filter.maxsize"
+	FilterSummaryIntKey string = "filter.summary.interval"
+	SamplingRateKey     string = "sampling.rate"
+	SamplingKeyKey      string = "sampling.key"
+	SamplingModeKey     string = "sampling.mode"
+	SamplingStatsIntKey string = "sampling.stats.interval"
 )
 
 // Config defines a configuration object for the engine.
 type Config struct {
 	FilterOnOff  OnOff
 	FilterMaxAge time.Duration
+	ExtOnOff     OnOff
+	// EntityOnOff enables the emission of entity records as flat records.
+	EntityOnOff OnOff
+	// FilterMaxSize bounds the number of entries of the filter, 0 for unbounded.
+	FilterMaxSize int
+	// FilterSummaryInt is the interval at which summaries of the suppressed records are emitted, 0 to disable.
+	FilterSummaryInt time.Duration
+	// FilterFields holds the fields hashed by the filter to detect duplicates, keyed by record type,
+	// or by the empty string for the record types without fields of their own.
+	FilterFields map[string][]string
+	// SamplingRates holds the fractions of records kept by the sampler, keyed by record type,
+	// or by record type and opflag separated by a dot (e.g., FF.READ).
+	SamplingRates map[string]float64
+	// SamplingKey holds the fields hashed by the sampler to keep or drop records coherently.
+	SamplingKey  []string
+	SamplingMode SamplingMode
+	// SamplingStatsInt is the interval at which the numbers of records sampled out are logged, 0 to disable.
+	SamplingStatsInt time.Duration
 }
 
 // CreateConfig creates a new config object from config dictionary.
 func CreateConfig(conf map[string]interface{}) (Config, error) {
-	var c Config = Config{FilterOnOff: Off, FilterMaxAge: 24 * time.Hour} // default values
+	var c Config = Config{FilterOnOff: Off, FilterMaxAge: 24 * time.Hour, ExtOnOff: Off, EntityOnOff: Off,
+		SamplingKey: []string{engine.SF_NODE_ID, engine.SF_PROC_OID}, SamplingStatsInt: time.Minute} // default values
 	var err error
 	if v, ok := conf[FilterOnOffKey].(string); ok {
 		c.FilterOnOff = parseOnOffType(v)
 	}
+	if v, ok := conf[ExtOnOffKey].(string); ok {
+		c.ExtOnOff = parseOnOffType(v)
+	}
+	if v, ok := conf[EntityOnOffKey].(string); ok {
+		c.EntityOnOff = parseOnOffType(v)
+	}
 	if v, ok := conf[FilterMaxAgeKey].(string); ok {
 		var duration int
 		duration, err = strconv.Atoi(v)
@@ -51,6 +89,60 @@ func CreateConfig(conf map[string]interface{}) (Config, error) {
 			c.FilterMaxAge = time.Duration(duration) * time.Second
 		}
 	}
+	if v, ok := conf[FilterMaxSizeKey].(string); ok {
+		var size int
+		size, err = strconv.Atoi(v)
+		if err == nil {
+			c.FilterMaxSize = size
+		}
+	}
+	if v, ok := conf[FilterSummaryIntKey].(string); ok {
+		var interval int
+		interval, err = strconv.Atoi(v)
+		if err == nil {
+			c.FilterSummaryInt = time.Duration(interval) * time.Second
+		}
+	}
+	if v, ok := conf[SamplingStatsIntKey].(string); ok {
+		var interval int
+		interval, err = strconv.Atoi(v)
+		if err == nil {
+			c.SamplingStatsInt = time.Duration(interval) * time.Second
+		}
+	}
+	if v, ok := conf[SamplingKeyKey].(string); ok {
+		c.SamplingKey = parseFields(v)
+	}
+	if v, ok := conf[SamplingModeKey].(string); ok {
+		c.SamplingMode = parseSamplingMode(v)
+	}
+	for k, v := range conf {
+		if !strings.HasPrefix(k, SamplingRateKey+".") {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			if c.SamplingRates == nil {
+				c.SamplingRates = make(map[string]float64)
+			}
+			rate, e := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if e != nil {
+				err = e
+				rate = math.NaN() // rejected when the sampler is created
+			}
+			c.SamplingRates[strings.TrimPrefix(k, SamplingRateKey+".")] = rate
+		}
+	}
+	for k, v := range conf {
+		if k != FilterFieldsKey && !strings.HasPrefix(k, FilterFieldsKey+".") {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			if c.FilterFields == nil {
+				c.FilterFields = make(map[string][]string)
+			}
+			c.FilterFields[strings.TrimPrefix(strings.TrimPrefix(k, FilterFieldsKey), ".")] = parseFields(s)
+		}
+	}
 	return c, err
 }
 
@@ -71,6 +163,39 @@ func (s OnOff) Enabled() bool {
 	return s == On
 }
 
+// SamplingMode defines how the sampler decides which records are kept.
+type SamplingMode int32
+
+// SamplingMode types.
+const (
+	// HashSampling keeps or drops records deterministically by hashing the sampling key, so that
+	// records sharing a key (e.g., all records of a process) are kept or dropped together.
+	HashSampling SamplingMode = iota
+	// RandomSampling keeps or drops each record independently at random.
+	RandomSampling
+)
+
+func (s SamplingMode) String() string {
+	return [...]string{"hash", "random"}[s]
+}
+
+func parseSamplingMode(s string) SamplingMode {
+	if RandomSampling.String() == s {
+		return RandomSampling
+	}
+	return HashSampling
+}
+
+func parseFields(s string) []string {
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 func parseOnOffType(s string) OnOff {
 	if Off.String() == s {
 		return Off
@@ -23,30 +23,56 @@ package flattener
 import (
 	"container/list"
 	"encoding/binary"
+	"fmt"
 	"time"
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 )
 
 var byteInt64 []byte = make([]byte, 8)
 
-// Filter is a time decaying filter with a TTL per entry.
+// Filter is a time decaying filter with a TTL per entry, optionally bounded in size. When the filter
+// is full, the least recently seen entry is evicted. A filter keeping records also records the
+// records it suppresses per entry, which can be reported as summary records.
 type Filter struct {
-	m   map[uint64]int64
-	q   *list.List
-	ttl time.Duration
+	m map[uint64]*list.Element
+	// q orders the entries by the time they were first seen, for expiry, and lru by the time
+	// they were last seen, for eviction when the filter is full.
+	q         *list.List
+	lru       *list.List
+	ttl       time.Duration
+	maxSize   int
+	keepRecs  bool
+	now       func() time.Time
+	pending   []*sfgo.FlatRecord
+	evictions uint64
 }
 
-// Entry encodes a hash value with the time it was first added to the filter.
+// Entry encodes a hash value with the time it was first added to the filter, and the record
+// first seen with the hash along with the timestamps of the records suppressed since the last summary.
 type Entry struct {
-	h         uint64
-	firstSeen time.Time
+	h          uint64
+	firstSeen  time.Time
+	count      int64
+	rec        *sfgo.FlatRecord
+	suppressed int64
+	firstTs    int64
+	lastTs     int64
+	lruEl      *list.Element
 }
 
 // NewFilter creates a new time decaying filter that evicts entries that have been seen longer than t duration.
 func NewFilter(t time.Duration) *Filter {
-	return &Filter{m: make(map[uint64]int64), q: list.New(), ttl: t}
+	return NewBoundedFilter(t, 0, false)
+}
+
+// NewBoundedFilter creates a new time decaying filter holding at most maxSize entries, 0 for unbounded.
+// If keepRecs is set, the filter records the suppressed records for summaries.
+func NewBoundedFilter(t time.Duration, maxSize int, keepRecs bool) *Filter {
+	return &Filter{m: make(map[uint64]*list.Element), q: list.New(), lru: list.New(), ttl: t, maxSize: maxSize, keepRecs: keepRecs, now: time.Now}
 }
 
 // Test tests if hash h has been seen since maximum ttl.
@@ -64,37 +90,127 @@ func (f *Filter) TestAndAdd(h uint64) bool {
 	return ok
 }
 
+// TestAndAddRecord tests if hash h of record fr has been seen since maximum ttl and adds or increments
+// the element in the filter cache, recording fr as suppressed if the hash has been seen.
+func (f *Filter) TestAndAddRecord(h uint64, fr *sfgo.FlatRecord) bool {
+	f.evictAgedEntries()
+	if el, ok := f.m[h]; ok {
+		e := el.Value.(*Entry)
+		e.count++
+		f.lru.MoveToBack(e.lruEl)
+		if f.keepRecs {
+			ts := engine.Mapper.MapInt(engine.SF_TS)(&engine.Record{Fr: *fr})
+			if e.suppressed == 0 || ts < e.firstTs {
+				e.firstTs = ts
+			}
+			if ts > e.lastTs {
+				e.lastTs = ts
+			}
+			e.suppressed++
+		}
+		return true
+	}
+	e := f.add(h)
+	if f.keepRecs {
+		e.rec = fr
+	}
+	return false
+}
+
 // Contains returns how many times hash h has been seen during its ttl time.
 func (f *Filter) Count(h uint64) int64 {
 	f.evictAgedEntries()
-	if count, ok := f.m[h]; ok {
-		return count
+	if el, ok := f.m[h]; ok {
+		return el.Value.(*Entry).count
 	}
 	return 0
 }
 
 // Add adds hash h to the filter.
 func (f *Filter) Add(h uint64) {
-	if v, ok := f.m[h]; !ok {
-		f.m[h] = 1
-		f.q.PushBack(Entry{h: h, firstSeen: time.Now()})
+	if el, ok := f.m[h]; !ok {
+		f.add(h)
 	} else {
-		f.m[h] = v + 1
+		e := el.Value.(*Entry)
+		e.count++
+		f.lru.MoveToBack(e.lruEl)
+	}
+}
+
+// Len returns the number of entries in the filter.
+func (f *Filter) Len() int {
+	return len(f.m)
+}
+
+// Evictions returns the number of entries evicted because the filter was full.
+func (f *Filter) Evictions() uint64 {
+	return f.evictions
+}
+
+// Summaries returns the summary records of the entries that suppressed records since the last call,
+// including the entries evicted or expired meanwhile, and resets their suppression counters.
+func (f *Filter) Summaries() []*sfgo.FlatRecord {
+	f.evictAgedEntries()
+	recs := f.pending
+	f.pending = nil
+	for el := f.q.Front(); el != nil; el = el.Next() {
+		if fr := f.summarize(el.Value.(*Entry)); fr != nil {
+			recs = append(recs, fr)
+		}
+	}
+	return recs
+}
+
+func (f *Filter) add(h uint64) *Entry {
+	if f.maxSize > 0 && len(f.m) >= f.maxSize {
+		f.remove(f.lru.Front().Value.(*Entry))
+		f.evictions++
+	}
+	e := &Entry{h: h, firstSeen: f.now(), count: 1}
+	f.m[h] = f.q.PushBack(e)
+	e.lruEl = f.lru.PushBack(e)
+	return e
+}
+
+func (f *Filter) remove(e *Entry) {
+	if fr := f.summarize(e); fr != nil {
+		f.pending = append(f.pending, fr)
 	}
+	f.q.Remove(f.m[e.h])
+	f.lru.Remove(e.lruEl)
+	delete(f.m, e.h)
 }
 
 func (f *Filter) evictAgedEntries() {
 	for f.q.Len() > 0 {
-		e := f.q.Front()
-		entry := e.Value.(Entry)
-		if time.Since(entry.firstSeen) < f.ttl {
+		el := f.q.Front()
+		if f.now().Sub(el.Value.(*Entry).firstSeen) < f.ttl {
 			break
 		}
-		f.q.Remove(e)
-		delete(f.m, entry.h)
+		f.remove(el.Value.(*Entry))
 	}
 }
 
+// summarize returns a copy of the record first seen with the hash of entry e, carrying the number
+// and time range of the records suppressed since the last summary, or nil if there are none.
+func (f *Filter) summarize(e *Entry) *sfgo.FlatRecord {
+	if e.rec == nil || e.suppressed == 0 {
+		return nil
+	}
+	fr := &sfgo.FlatRecord{Sources: e.rec.Sources, Strs: e.rec.Strs, Anys: e.rec.Anys, Ptree: e.rec.Ptree, GraphletID: e.rec.GraphletID}
+	fr.Ints = make([][]int64, len(e.rec.Ints))
+	copy(fr.Ints, e.rec.Ints)
+	ints := make([]int64, layout.INT_ARRAY_SIZE)
+	copy(ints, e.rec.Ints[sfgo.SYSFLOW_IDX])
+	ints[layout.DEDUP_SUMMARY_INT] = 1
+	ints[layout.DEDUP_COUNT_INT] = e.suppressed
+	ints[layout.DEDUP_FIRSTTS_INT] = e.firstTs
+	ints[layout.DEDUP_LASTTS_INT] = e.lastTs
+	fr.Ints[sfgo.SYSFLOW_IDX] = ints
+	e.suppressed, e.firstTs, e.lastTs = 0, 0, 0
+	return fr
+}
+
 // semanticHash computes a hash value over record attributes denoting the semantics of the record (used in the time decay filter).
 func semanticHash(fr *sfgo.FlatRecord) uint64 {
 	h := xxhash.New()
@@ -109,7 +225,7 @@ func semanticHash(fr *sfgo.FlatRecord) uint64 {
 	binary.LittleEndian.PutUint64(byteInt64, uint64(fr.Ints[sfgo.SYSFLOW_SRC][sfgo.PROC_TTY_INT]))
 	h.Write(byteInt64)
 	sfType := fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE]
-	if sfType == sfgo.NET_FLOW {
+	if sfType == sfgo.NET_FLOW || sfType == sfgo.NET_EVT {
 		binary.LittleEndian.PutUint64(byteInt64, uint64(fr.Ints[sfgo.SYSFLOW_SRC][sfgo.FL_NETW_SIP_INT]))
 		h.Write(byteInt64)
 		binary.LittleEndian.PutUint64(byteInt64, uint64(fr.Ints[sfgo.SYSFLOW_SRC][sfgo.FL_NETW_DIP_INT]))
@@ -119,8 +235,75 @@ func semanticHash(fr *sfgo.FlatRecord) uint64 {
 		binary.LittleEndian.PutUint64(byteInt64, uint64(fr.Ints[sfgo.SYSFLOW_SRC][sfgo.FL_NETW_PROTO_INT]))
 		h.Write(byteInt64)
 	}
-	if sfType == sfgo.FILE_FLOW || sfType == sfgo.FILE_EVT {
+	if sfType == sfgo.FILE_FLOW || sfType == sfgo.FILE_EVT || sfType == sfgo.FILE {
 		h.Write([]byte(fr.Strs[sfgo.SYSFLOW_SRC][sfgo.FILE_PATH_STR]))
 	}
+	if sfType == sfgo.CONT {
+		h.Write([]byte(fr.Strs[sfgo.SYSFLOW_SRC][sfgo.CONT_ID_STR]))
+	}
+	if sfType == sfgo.POD {
+		h.Write([]byte(fr.Strs[sfgo.SYSFLOW_SRC][sfgo.POD_ID_STR]))
+	}
+	return h.Sum64()
+}
+
+// fieldHash computes a hash value over configured record attributes, per record type. Record types
+// without configured attributes are hashed with semanticHash.
+type fieldHash struct {
+	fields map[string][]engine.StrFieldMap
+	dflt   []engine.StrFieldMap
+}
+
+// newFieldHash creates a hash function over the fields configured for each record type. Fields are
+// policy engine attribute names, and record types are the values of the sf.type attribute.
+func newFieldHash(fields map[string][]string) (*fieldHash, error) {
+	fh := &fieldHash{fields: make(map[string][]engine.StrFieldMap)}
+	for rtype, attrs := range fields {
+		if rtype != "" {
+			if _, err := sfgo.ParseRecordTypeStr(rtype); err != nil {
+				return nil, fmt.Errorf("unknown record type '%s' in attribute '%s'", rtype, fieldsKey(rtype))
+			}
+		}
+		if len(attrs) == 0 {
+			return nil, fmt.Errorf("attribute '%s' lists no fields", fieldsKey(rtype))
+		}
+		var maps []engine.StrFieldMap
+		for _, attr := range attrs {
+			if !engine.Mapper.IsField(attr) {
+				return nil, fmt.Errorf("unknown field '%s' in attribute '%s'", attr, fieldsKey(rtype))
+			}
+			maps = append(maps, engine.Mapper.MapStr(attr))
+		}
+		if rtype == "" {
+			fh.dflt = maps
+		} else {
+			fh.fields[rtype] = maps
+		}
+	}
+	return fh, nil
+}
+
+func fieldsKey(rtype string) string {
+	if rtype == "" {
+		return FilterFieldsKey
+	}
+	return FilterFieldsKey + "." + rtype
+}
+
+// hash computes a hash value over the fields configured for the type of record fr.
+func (fh *fieldHash) hash(fr *sfgo.FlatRecord) uint64 {
+	r := &engine.Record{Fr: *fr}
+	maps, ok := fh.fields[engine.Mapper.MapStr(engine.SF_TYPE)(r)]
+	if !ok {
+		maps = fh.dflt
+	}
+	if maps == nil {
+		return semanticHash(fr)
+	}
+	h := xxhash.New()
+	for _, m := range maps {
+		h.WriteString(m(r))
+		h.Write([]byte{0})
+	}
 	return h.Sum64()
 }
@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package flattener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func newFileFlow(exe string, args string, path string, cont string) *sfgo.FlatRecord {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.FILE_FLOW
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXE_STR] = exe
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_EXEARGS_STR] = args
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.FILE_PATH_STR] = path
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.CONT_ID_STR] = cont
+	return fr
+}
+
+func TestFieldHash(t *testing.T) {
+	conf, _ := CreateConfig(map[string]interface{}{"filter.fields.FF": "sf.proc.exe, sf.file.path,sf.container.id"})
+	assert.Equal(t, []string{"sf.proc.exe", "sf.file.path", "sf.container.id"}, conf.FilterFields["FF"])
+	fh, err := newFieldHash(conf.FilterFields)
+	if !assert.NoError(t, err) {
+		return
+	}
+	a := newFileFlow("/bin/cat", "/etc/passwd", "/etc/passwd", "c1")
+	assert.Equal(t, fh.hash(a), fh.hash(newFileFlow("/bin/cat", "-n /etc/passwd", "/etc/passwd", "c1")), "args are not hashed")
+	assert.NotEqual(t, fh.hash(a), fh.hash(newFileFlow("/bin/cat", "/etc/passwd", "/etc/passwd", "c2")), "container IDs are hashed")
+
+	// record types without configured fields keep the built-in hash
+	pe := newFileFlow("/bin/cat", "/etc/passwd", "", "c1")
+	pe.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.PROC_EVT
+	assert.Equal(t, semanticHash(pe), fh.hash(pe))
+
+	for _, conf := range []map[string]interface{}{
+		{"filter.fields": "sf.proc.exe,sf.no.such.field"},
+		{"filter.fields.XX": "sf.proc.exe"},
+		{"filter.fields.PE": " , "},
+	} {
+		assert.Error(t, new(Flattener).Init(conf), "%v", conf)
+	}
+	assert.NoError(t, new(Flattener).Init(map[string]interface{}{"filter.fields": "sf.proc.exe,sf.proc.aname[2]"}))
+}
+
+func TestBoundedFilter(t *testing.T) {
+	now := time.Unix(0, 0)
+	f := NewBoundedFilter(time.Minute, 2, true)
+	f.now = func() time.Time { return now }
+	rec := func(path string, ts int64) *sfgo.FlatRecord {
+		fr := newFileFlow("/bin/cat", "", path, "")
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = ts
+		return fr
+	}
+
+	assert.False(t, f.TestAndAddRecord(1, rec("/a", 10)))
+	assert.True(t, f.TestAndAddRecord(1, rec("/a", 30)))
+	assert.True(t, f.TestAndAddRecord(1, rec("/a", 20)))
+	assert.False(t, f.TestAndAddRecord(2, rec("/b", 40)))
+	sums := f.Summaries()
+	if assert.Len(t, sums, 1, "no records suppressed for /b") {
+		r := engine.NewRecord(*sums[0])
+		assert.True(t, r.IsSummary())
+		assert.Equal(t, int64(2), engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(r))
+		assert.Equal(t, int64(20), engine.Mapper.MapInt(engine.SF_DEDUP_FIRSTTS)(r))
+		assert.Equal(t, int64(30), engine.Mapper.MapInt(engine.SF_DEDUP_LASTTS)(r))
+	}
+	assert.Empty(t, f.Summaries(), "no records suppressed since the last summary")
+
+	assert.True(t, f.TestAndAddRecord(2, rec("/b", 50)))
+	assert.True(t, f.TestAndAddRecord(1, rec("/a", 60)))
+	sums = f.Summaries()
+	if assert.Len(t, sums, 2) {
+		r := engine.NewRecord(*sums[0])
+		assert.Equal(t, "/a", engine.Mapper.MapStr(engine.SF_FILE_PATH)(r))
+		assert.Equal(t, int64(1), engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(r))
+		assert.Equal(t, int64(60), engine.Mapper.MapInt(engine.SF_DEDUP_FIRSTTS)(r))
+	}
+
+	// the least recently seen entry is evicted when the filter is full, along with its summary
+	assert.True(t, f.TestAndAddRecord(2, rec("/b", 65)))
+	assert.True(t, f.TestAndAddRecord(1, rec("/a", 70)))
+	assert.False(t, f.TestAndAddRecord(3, rec("/c", 80)))
+	assert.Equal(t, 2, f.Len())
+	assert.Equal(t, uint64(1), f.Evictions())
+	assert.False(t, f.Test(2))
+	assert.True(t, f.Test(1), "entry first seen longest ago is kept when seen recently")
+	sums = f.Summaries()
+	if assert.Len(t, sums, 2) {
+		r := engine.NewRecord(*sums[0])
+		assert.Equal(t, "/b", engine.Mapper.MapStr(engine.SF_FILE_PATH)(r))
+		assert.Equal(t, int64(65), engine.Mapper.MapInt(engine.SF_DEDUP_LASTTS)(r))
+		sums = sums[1:]
+	}
+	if assert.Len(t, sums, 1) {
+		r := engine.NewRecord(*sums[0])
+		assert.Equal(t, int64(70), engine.Mapper.MapInt(engine.SF_DEDUP_LASTTS)(r))
+		assert.Equal(t, int64(0), engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(engine.NewRecord(*rec("/a", 70))))
+		assert.False(t, engine.NewRecord(*rec("/a", 70)).IsSummary())
+	}
+
+	// aged entries expire
+	now = now.Add(time.Minute)
+	assert.False(t, f.TestAndAddRecord(2, rec("/b", 90)))
+	assert.Equal(t, 1, f.Len())
+}
@@ -25,10 +25,13 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
 )
 
 const (
@@ -48,9 +51,15 @@ func NewFlattenerChan(size int) interface{} {
 
 // Flattener defines the main class for the flatterner plugin.
 type Flattener struct {
-	config Config
-	filter *Filter
-	outCh  []chan *sfgo.FlatRecord
+	config  Config
+	filter  *Filter
+	sampler *Sampler
+	hash    func(fr *sfgo.FlatRecord) uint64
+	outCh   []chan *sfgo.FlatRecord
+	// mu guards the filter, the sampler and the output channels against the tickers.
+	mu   sync.Mutex
+	done chan struct{}
+	wg   sync.WaitGroup
 }
 
 // NewFlattener creates a new Flattener instance.
@@ -71,16 +80,35 @@ func (s *Flattener) RegisterHandler(hc plugins.SFHandlerCache) {
 // Init initializes the handler with a configuration map.
 func (s *Flattener) Init(conf map[string]interface{}) error {
 	s.config, _ = CreateConfig(conf) // no err check, assuming defaults
+	s.hash = semanticHash
+	if s.config.FilterFields != nil {
+		fh, err := newFieldHash(s.config.FilterFields)
+		if err != nil {
+			return err
+		}
+		s.hash = fh.hash
+	}
+	if s.config.SamplingRates != nil {
+		sampler, err := NewSampler(s.config.SamplingRates, s.config.SamplingKey, s.config.SamplingMode)
+		if err != nil {
+			return err
+		}
+		s.sampler = sampler
+		logger.Info.Printf("Initialized %s sampler with %d rates", s.config.SamplingMode, len(s.config.SamplingRates))
+	}
 	if s.config.FilterOnOff.Enabled() {
-		s.filter = NewFilter(s.config.FilterMaxAge)
-		logger.Info.Printf("Initialized rate limiter with %s time decay", s.config.FilterMaxAge)
+		s.filter = NewBoundedFilter(s.config.FilterMaxAge, s.config.FilterMaxSize, s.config.FilterSummaryInt > 0)
+		logger.Info.Printf("Initialized rate limiter with %s time decay and %d max entries", s.config.FilterMaxAge, s.config.FilterMaxSize)
+		if s.config.FilterSummaryInt > 0 {
+			logger.Info.Printf("Emitting summaries of suppressed records every %s; policy engines in 'alert' mode drop them", s.config.FilterSummaryInt)
+		}
 	}
 	return nil
 }
 
 // IsEntityEnabled is used to check if the flattener returns entity records.
 func (s *Flattener) IsEntityEnabled() bool {
-	return false
+	return s.config.EntityOnOff.Enabled()
 }
 
 // SetOutChan sets the plugin output channel.
@@ -88,21 +116,95 @@ func (s *Flattener) SetOutChan(chObj []interface{}) {
 	for _, ch := range chObj {
 		s.outCh = append(s.outCh, ch.(*FlatChannel).In)
 	}
+	s.startTickers()
 }
 
 // out sends a record to every output channel in the plugin.
 func (s *Flattener) out(fr *sfgo.FlatRecord) {
-	if s.config.FilterOnOff.Enabled() && s.filter != nil && s.filter.TestAndAdd(semanticHash(fr)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sampler != nil && !s.sampler.Keep(fr) {
 		return
 	}
+	if s.config.FilterOnOff.Enabled() && s.filter != nil {
+		if s.filter.TestAndAddRecord(s.hash(fr), fr) {
+			return
+		}
+	}
 	for _, c := range s.outCh {
 		c <- fr
 	}
 }
 
+// startTickers periodically sends the summaries of the records suppressed by the filter, so that
+// summaries are emitted even when no records arrive, and logs the numbers of records sampled out.
+func (s *Flattener) startTickers() {
+	var summary, stats <-chan time.Time
+	var tickers []*time.Ticker
+	if s.filter != nil && s.config.FilterSummaryInt > 0 {
+		ticker := time.NewTicker(s.config.FilterSummaryInt)
+		tickers = append(tickers, ticker)
+		summary = ticker.C
+	}
+	if s.sampler != nil && s.config.SamplingStatsInt > 0 {
+		ticker := time.NewTicker(s.config.SamplingStatsInt)
+		tickers = append(tickers, ticker)
+		stats = ticker.C
+	}
+	if len(tickers) == 0 {
+		return
+	}
+	s.done = make(chan struct{})
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case <-summary:
+				s.summarize()
+			case <-stats:
+				s.logSampling()
+			case <-s.done:
+				for _, t := range tickers {
+					t.Stop()
+				}
+				return
+			}
+		}
+	}()
+}
+
+// logSampling logs the numbers of records sampled out since the flattener started.
+func (s *Flattener) logSampling() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	logger.Info.Printf("Records sampled out by the flattener: %s", s.sampler)
+}
+
+// summarize sends the summaries of the records suppressed by the filter to every output channel.
+func (s *Flattener) summarize() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, fr := range s.filter.Summaries() {
+		for _, c := range s.outCh {
+			c <- fr
+		}
+	}
+}
+
 // Cleanup tears down resources.
 func (s *Flattener) Cleanup() {
 	logger.Trace.Println("Calling Cleanup on Flattener channel")
+	if s.done != nil {
+		close(s.done)
+		s.wg.Wait()
+	}
+	if s.filter != nil && s.config.FilterSummaryInt > 0 {
+		s.summarize()
+	}
+	if s.sampler != nil {
+		s.logSampling()
+	}
 	if s.outCh != nil {
 		for _, ch := range s.outCh {
 			close(ch)
@@ -117,11 +219,26 @@ func (s *Flattener) HandleHeader(sf *plugins.CtxSysFlow, hdr *sfgo.SFHeader) err
 
 // HandleContainer processes Container entities.
 func (s *Flattener) HandleContainer(sf *plugins.CtxSysFlow, cont *sfgo.Container) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.CONT
+	s.fillHeader(sf.Header, fr)
+	s.fillContainer(cont, fr)
+	s.fillPod(sf.Pod, fr)
+	// containers carry no timestamp, so their records are stamped with the processing time
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = time.Now().UnixNano()
+	s.out(fr)
 	return nil
 }
 
 // HandlePod processes Pod entities.
-func (s *Flattener) HandlePod(sf *plugins.CtxSysFlow, cont *sfgo.Pod) error {
+func (s *Flattener) HandlePod(sf *plugins.CtxSysFlow, pod *sfgo.Pod) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.POD
+	s.fillHeader(sf.Header, fr)
+	s.fillContainer(nil, fr)
+	s.fillPod(pod, fr)
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = pod.Ts
+	s.out(fr)
 	return nil
 }
 
@@ -142,11 +259,27 @@ func (s *Flattener) HandleK8sEvt(sf *plugins.CtxSysFlow, ke *sfgo.K8sEvent) erro
 
 // HandleProcess processes Process entities.
 func (s *Flattener) HandleProcess(sf *plugins.CtxSysFlow, proc *sfgo.Process) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.PROC
+	s.fillEntities(sf.Header, sf.Pod, sf.Container, proc, nil, fr)
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = proc.Ts
+	fr.Ptree = sf.PTree
+	fr.GraphletID = sf.GraphletID
+	s.out(fr)
 	return nil
 }
 
 // HandleFile processes File entities.
 func (s *Flattener) HandleFile(sf *plugins.CtxSysFlow, file *sfgo.File) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.FILE
+	s.fillHeader(sf.Header, fr)
+	s.fillContainer(sf.Container, fr)
+	s.fillPod(sf.Pod, fr)
+	s.fillProcess(nil, fr)
+	s.fillFile(file, fr)
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.TS_INT] = file.Ts
+	s.out(fr)
 	return nil
 }
 
@@ -232,11 +365,38 @@ func (s *Flattener) HandleFileEvt(sf *plugins.CtxSysFlow, fe *sfgo.FileEvent) er
 
 // HandleNetEvt processes Network Events.
 func (s *Flattener) HandleNetEvt(sf *plugins.CtxSysFlow, ne *sfgo.NetworkEvent) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.NET_EVT
+	s.fillEntities(sf.Header, sf.Pod, sf.Container, sf.Process, nil, fr)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_TS_INT] = ne.Ts
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_TID_INT] = ne.Tid
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_OPFLAGS_INT] = int64(ne.OpFlags)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_RET_INT] = int64(ne.Ret)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_SIP_INT] = int64(ne.Sip)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_SPORT_INT] = int64(ne.Sport)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_DIP_INT] = int64(ne.Dip)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_DPORT_INT] = int64(ne.Dport)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_PROTO_INT] = int64(ne.Proto)
+	fr.Ptree = sf.PTree
+	fr.GraphletID = sf.GraphletID
+	s.out(fr)
 	return nil
 }
 
 // HandleProcFlow processes Process Flows.
 func (s *Flattener) HandleProcFlow(sf *plugins.CtxSysFlow, pf *sfgo.ProcessFlow) error {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.PROC_FLOW
+	s.fillEntities(sf.Header, sf.Pod, sf.Container, sf.Process, nil, fr)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_TS_INT] = pf.Ts
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_OPFLAGS_INT] = int64(pf.OpFlags)
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_ENDTS_INT] = pf.EndTs
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMTHREADSCLONED_INT] = pf.NumThreadsCloned
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMTHREADSEXITED_INT] = pf.NumThreadsExited
+	fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMCLONEERRORS_INT] = pf.NumCloneErrors
+	fr.Ptree = sf.PTree
+	fr.GraphletID = sf.GraphletID
+	s.out(fr)
 	return nil
 }
 
@@ -271,6 +431,19 @@ func (s *Flattener) fillHeader(hdr *sfgo.SFHeader, fr *sfgo.FlatRecord) {
 
 func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.Container, proc *sfgo.Process, file *sfgo.File, fr *sfgo.FlatRecord) {
 	s.fillHeader(hdr, fr)
+	s.fillContainer(cont, fr)
+	s.fillPod(pod, fr)
+	if proc == nil {
+		logger.Warn.Println("Event does not have a related process.  This should not happen.")
+	}
+	s.fillProcess(proc, fr)
+	s.fillFile(file, fr)
+	if s.config.ExtOnOff.Enabled() && proc != nil {
+		fillExtProcess(proc, fr)
+	}
+}
+
+func (s *Flattener) fillContainer(cont *sfgo.Container, fr *sfgo.FlatRecord) {
 	if cont != nil {
 		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.CONT_ID_STR] = cont.Id
 		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.CONT_NAME_STR] = strings.TrimSpace(cont.Name)
@@ -290,6 +463,9 @@ func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.C
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.CONT_TYPE_INT] = sfgo.Zeros.Int64
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.CONT_PRIVILEGED_INT] = sfgo.Zeros.Int64
 	}
+}
+
+func (s *Flattener) fillPod(pod *sfgo.Pod, fr *sfgo.FlatRecord) {
 	if pod != nil {
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.POD_TS_INT] = pod.Ts
 		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.POD_ID_STR] = pod.Id
@@ -317,6 +493,9 @@ func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.C
 		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.POD_SERVICES_STR] = sfgo.Zeros.String
 		fr.Anys[sfgo.SYSFLOW_IDX][sfgo.POD_SERVICES_ANY] = sfgo.Zeros.Any
 	}
+}
+
+func (s *Flattener) fillProcess(proc *sfgo.Process, fr *sfgo.FlatRecord) {
 	if proc != nil {
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_STATE_INT] = int64(proc.State)
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_OID_CREATETS_INT] = int64(proc.Oid.CreateTS)
@@ -351,7 +530,6 @@ func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.C
 			fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_CONTAINERID_STRING_STR] = sfgo.Zeros.String
 		}
 	} else {
-		logger.Warn.Println("Event does not have a related process.  This should not happen.")
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_STATE_INT] = sfgo.Zeros.Int64
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_OID_CREATETS_INT] = sfgo.Zeros.Int64
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_OID_HPID_INT] = sfgo.Zeros.Int64
@@ -368,6 +546,9 @@ func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.C
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_ENTRY_INT] = sfgo.Zeros.Int64
 		fr.Strs[sfgo.SYSFLOW_IDX][sfgo.PROC_CONTAINERID_STRING_STR] = sfgo.Zeros.String
 	}
+}
+
+func (s *Flattener) fillFile(file *sfgo.File, fr *sfgo.FlatRecord) {
 	if file != nil {
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FILE_STATE_INT] = int64(file.State)
 		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FILE_TS_INT] = file.Ts
@@ -389,6 +570,17 @@ func (s *Flattener) fillEntities(hdr *sfgo.SFHeader, pod *sfgo.Pod, cont *sfgo.C
 	}
 }
 
+// fillExtProcess adds an extended process source to a flat record, populating the attributes derivable from a SysFlow process.
+// Hashes, signatures and other extended attributes not carried by SysFlow entities are left empty.
+func fillExtProcess(proc *sfgo.Process, fr *sfgo.FlatRecord) {
+	strs := make([]string, sfgo.NUM_EXT_PROC_ATTRS_STR)
+	strs[sfgo.PROC_IMAGE_STR] = strings.TrimSpace(proc.Exe)
+	fr.Sources = append(fr.Sources, sfgo.PROCESS_SRC)
+	fr.Ints = append(fr.Ints, make([]int64, sfgo.NUM_EXT_PROC_ATTRS_INT))
+	fr.Strs = append(fr.Strs, strs)
+	fr.Anys = append(fr.Anys, nil)
+}
+
 func getIPStr(ips *[]int64) string {
 	var sb strings.Builder
 	sb.WriteByte('[')
@@ -417,7 +609,7 @@ func newFlatRecord() *sfgo.FlatRecord {
 	fr.Strs = make([][]string, 1)
 	fr.Anys = make([][]interface{}, 1)
 	fr.Sources[sfgo.SYSFLOW_IDX] = sfgo.SYSFLOW_SRC
-	fr.Ints[sfgo.SYSFLOW_IDX] = make([]int64, sfgo.INT_ARRAY_SIZE)
+	fr.Ints[sfgo.SYSFLOW_IDX] = make([]int64, layout.INT_ARRAY_SIZE)
 	fr.Strs[sfgo.SYSFLOW_IDX] = make([]string, sfgo.STR_ARRAY_SIZE)
 	fr.Anys[sfgo.SYSFLOW_IDX] = make([]interface{}, sfgo.ANY_ARRAY_SIZE)
 	return fr
@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package flattener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+func TestEntityRecords(t *testing.T) {
+	s := NewFlattener().(*Flattener)
+	assert.NoError(t, s.Init(map[string]interface{}{}))
+	assert.False(t, s.IsEntityEnabled())
+	assert.NoError(t, s.Init(map[string]interface{}{EntityOnOffKey: "on"}))
+	assert.True(t, s.IsEntityEnabled())
+	out := NewFlattenerChan(10).(*FlatChannel)
+	s.SetOutChan([]interface{}{out})
+
+	hdr := &sfgo.SFHeader{Exporter: "node1"}
+	pod := &sfgo.Pod{Ts: 1, Id: "pod1", Name: "web", Namespace: "default"}
+	cont := &sfgo.Container{Id: "c1", Name: "nginx", Privileged: true}
+	proc := &sfgo.Process{State: sfgo.SFObjectStateCREATED, Oid: &sfgo.OID{CreateTS: 2, Hpid: 42}, Ts: 2, Exe: "/usr/sbin/nginx"}
+	file := &sfgo.File{State: sfgo.SFObjectStateMODIFIED, Ts: 3, Path: "/etc/nginx/nginx.conf"}
+	sf := &plugins.CtxSysFlow{Header: hdr, Pod: pod, Container: cont, Process: proc}
+	assert.NoError(t, s.HandlePod(sf, pod))
+	assert.NoError(t, s.HandleContainer(sf, cont))
+	assert.NoError(t, s.HandleProcess(sf, proc))
+	assert.NoError(t, s.HandleFile(sf, file))
+
+	for _, tc := range []struct {
+		rtype string
+		state string
+		ts    int64
+		check func(r *engine.Record)
+	}{
+		{sfgo.TyPDStr, "", 1, func(r *engine.Record) {
+			assert.Equal(t, "web", engine.Mapper.MapStr(engine.SF_POD_NAME)(r))
+			assert.Equal(t, "", engine.Mapper.MapStr(engine.SF_CONTAINER_ID)(r))
+		}},
+		{sfgo.TyCStr, "", 0, func(r *engine.Record) {
+			assert.Equal(t, int64(1), engine.Mapper.MapInt(engine.SF_CONTAINER_PRIVILEGED)(r))
+			assert.Equal(t, "pod1", engine.Mapper.MapStr(engine.SF_POD_ID)(r))
+		}},
+		{sfgo.TyPStr, sfgo.SFObjectStateCREATED.String(), 2, func(r *engine.Record) {
+			assert.Equal(t, "/usr/sbin/nginx", engine.Mapper.MapStr(engine.SF_PROC_EXE)(r))
+			assert.Equal(t, int64(42), engine.Mapper.MapInt(engine.SF_PROC_PID)(r))
+			assert.Equal(t, "c1", engine.Mapper.MapStr(engine.SF_CONTAINER_ID)(r))
+		}},
+		{sfgo.TyFStr, sfgo.SFObjectStateMODIFIED.String(), 3, func(r *engine.Record) {
+			assert.Equal(t, "/etc/nginx/nginx.conf", engine.Mapper.MapStr(engine.SF_FILE_PATH)(r))
+			assert.Equal(t, "", engine.Mapper.MapStr(engine.SF_PROC_EXE)(r))
+		}},
+	} {
+		fr := <-out.In
+		r := &engine.Record{Fr: *fr}
+		assert.Equal(t, tc.rtype, engine.Mapper.MapStr(engine.SF_TYPE)(r))
+		assert.Equal(t, tc.state, engine.Mapper.MapStr(engine.SF_ENTITY_STATE)(r), tc.rtype)
+		assert.Equal(t, "node1", engine.Mapper.MapStr(engine.SF_NODE_ID)(r), tc.rtype)
+		if tc.ts != 0 {
+			assert.Equal(t, tc.ts, engine.Mapper.MapInt(engine.SF_TS)(r), tc.rtype)
+		} else {
+			assert.NotZero(t, engine.Mapper.MapInt(engine.SF_TS)(r), tc.rtype)
+		}
+		tc.check(r)
+	}
+}
+
+func TestSummaryTicker(t *testing.T) {
+	s := NewFlattener().(*Flattener)
+	assert.NoError(t, s.Init(map[string]interface{}{FilterOnOffKey: "on", FilterSummaryIntKey: "1"}))
+	out := NewFlattenerChan(10).(*FlatChannel)
+	s.SetOutChan([]interface{}{out})
+
+	s.out(newFileFlow("/bin/cat", "", "/a", ""))
+	s.out(newFileFlow("/bin/cat", "", "/a", ""))
+	assert.False(t, engine.NewRecord(*<-out.In).IsSummary())
+
+	// summaries are emitted without further records
+	select {
+	case fr := <-out.In:
+		r := engine.NewRecord(*fr)
+		assert.True(t, r.IsSummary())
+		assert.Equal(t, int64(1), engine.Mapper.MapInt(engine.SF_DEDUP_COUNT)(r))
+	case <-time.After(3 * time.Second):
+		assert.Fail(t, "no summary emitted")
+	}
+	s.Cleanup()
+	_, ok := <-out.In
+	assert.False(t, ok)
+}
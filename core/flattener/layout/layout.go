@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package layout defines the flat record indices of attributes that extend the flat
+// record schema of sf-apis. The flattener, the aggregator and the policy engine all
+// index the int array of the SysFlow source through this layout.
+package layout
+
+import "github.com/sysflow-telemetry/sf-apis/go/sfgo"
+
+// Flat record indices of process flow and network event attributes. Timestamps, operation
+// flags, addresses and ports use the network flow indices, so that the common attributes
+// map all flow and event types. Attributes without a network flow counterpart extend the
+// int array of the SysFlow source, so they never alias file or network flow counters.
+const (
+	FL_PROC_TS_INT               sfgo.Attribute = sfgo.FL_NETW_TS_INT
+	FL_PROC_OPFLAGS_INT          sfgo.Attribute = sfgo.FL_NETW_OPFLAGS_INT
+	FL_PROC_ENDTS_INT            sfgo.Attribute = sfgo.FL_NETW_ENDTS_INT
+	FL_PROC_NUMTHREADSCLONED_INT sfgo.Attribute = sfgo.INT_ARRAY_SIZE
+	FL_PROC_NUMTHREADSEXITED_INT sfgo.Attribute = FL_PROC_NUMTHREADSCLONED_INT + 1
+	FL_PROC_NUMCLONEERRORS_INT   sfgo.Attribute = FL_PROC_NUMTHREADSEXITED_INT + 1
+
+	EV_NET_TS_INT      sfgo.Attribute = sfgo.FL_NETW_TS_INT
+	EV_NET_TID_INT     sfgo.Attribute = sfgo.FL_NETW_TID_INT
+	EV_NET_OPFLAGS_INT sfgo.Attribute = sfgo.FL_NETW_OPFLAGS_INT
+	EV_NET_RET_INT     sfgo.Attribute = FL_PROC_NUMCLONEERRORS_INT + 1
+	EV_NET_SIP_INT     sfgo.Attribute = sfgo.FL_NETW_SIP_INT
+	EV_NET_SPORT_INT   sfgo.Attribute = sfgo.FL_NETW_SPORT_INT
+	EV_NET_DIP_INT     sfgo.Attribute = sfgo.FL_NETW_DIP_INT
+	EV_NET_DPORT_INT   sfgo.Attribute = sfgo.FL_NETW_DPORT_INT
+	EV_NET_PROTO_INT   sfgo.Attribute = sfgo.FL_NETW_PROTO_INT
+)
+
+// Flat record indices of the attributes of summary records, i.e., dedup summaries and flow
+// aggregates. DEDUP_SUMMARY_INT is set to 1 in the summaries of records suppressed by the
+// flattener's filter.
+const (
+	DEDUP_SUMMARY_INT sfgo.Attribute = EV_NET_RET_INT + 1
+	DEDUP_COUNT_INT   sfgo.Attribute = DEDUP_SUMMARY_INT + 1
+	DEDUP_FIRSTTS_INT sfgo.Attribute = DEDUP_COUNT_INT + 1
+	DEDUP_LASTTS_INT  sfgo.Attribute = DEDUP_FIRSTTS_INT + 1
+	AGG_COUNT_INT     sfgo.Attribute = DEDUP_LASTTS_INT + 1
+)
+
+// INT_ARRAY_SIZE is the size of the int array of the SysFlow source of flattened records,
+// which holds all the attributes above.
+const INT_ARRAY_SIZE sfgo.Attribute = AGG_COUNT_INT + 1
@@ -0,0 +1,208 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flattener flattens input telemetry in a flattened representation.
+package flattener
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+// opFlagBits maps opflag names, as used in sampling attributes, to opflag bits.
+var opFlagBits = map[string]int64{
+	sfgo.OpFlagClone:    sfgo.OP_CLONE,
+	sfgo.OpFlagExec:     sfgo.OP_EXEC,
+	sfgo.OpFlagExit:     sfgo.OP_EXIT,
+	sfgo.OpFlagSetuid:   sfgo.OP_SETUID,
+	sfgo.OpFlagSetns:    sfgo.OP_SETNS,
+	sfgo.OpFlagAccept:   sfgo.OP_ACCEPT,
+	sfgo.OpFlagConnect:  sfgo.OP_CONNECT,
+	sfgo.OpFlagOpen:     sfgo.OP_OPEN,
+	sfgo.OpFlagRead:     sfgo.OP_READ_RECV,
+	sfgo.OpFlagReceive:  sfgo.OP_READ_RECV,
+	sfgo.OpFlagWrite:    sfgo.OP_WRITE_SEND,
+	sfgo.OpFlagSend:     sfgo.OP_WRITE_SEND,
+	sfgo.OpFlagClose:    sfgo.OP_CLOSE,
+	sfgo.OpFlagTruncate: sfgo.OP_TRUNCATE,
+	sfgo.OpFlagShutdown: sfgo.OP_SHUTDOWN,
+	sfgo.OpFlagMmap:     sfgo.OP_MMAP,
+	sfgo.OpFlagDigest:   sfgo.OP_DIGEST,
+	sfgo.OpFlagMkdir:    sfgo.OP_MKDIR,
+	sfgo.OpFlagRmdir:    sfgo.OP_RMDIR,
+	sfgo.OpFlagLink:     sfgo.OP_LINK,
+	sfgo.OpFlagUnlink:   sfgo.OP_UNLINK,
+	sfgo.OpFlagSymlink:  sfgo.OP_SYMLINK,
+	sfgo.OpFlagRename:   sfgo.OP_RENAME,
+}
+
+// opFlagRate is the sampling rate of the records of a type carrying an opflag.
+type opFlagRate struct {
+	bit  int64
+	rate float64
+}
+
+// typeRates holds the sampling rates configured for a record type.
+type typeRates struct {
+	rate    float64
+	opFlags []opFlagRate
+}
+
+// Sampler keeps a configured fraction of the records of each type, optionally refined by opflags.
+// A record carrying several opflags is sampled at the highest rate among them, and records of types
+// without configured rates are always kept.
+type Sampler struct {
+	rates   map[string]*typeRates
+	key     []engine.StrFieldMap
+	mode    SamplingMode
+	rnd     *rand.Rand
+	dropped map[string]uint64
+}
+
+// NewSampler creates a new sampler from the rates keyed by record type or record type and opflag,
+// hashing the key fields in hash mode.
+func NewSampler(rates map[string]float64, key []string, mode SamplingMode) (*Sampler, error) {
+	s := &Sampler{
+		rates:   make(map[string]*typeRates),
+		mode:    mode,
+		rnd:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		dropped: make(map[string]uint64),
+	}
+	for k, rate := range rates {
+		if !(rate >= 0 && rate <= 1) {
+			return nil, fmt.Errorf("attribute '%s' must be a rate between 0 and 1", rateKey(k))
+		}
+		rtype, opFlag := k, ""
+		if i := strings.Index(k, "."); i >= 0 {
+			rtype, opFlag = k[:i], k[i+1:]
+		}
+		if _, err := sfgo.ParseRecordTypeStr(rtype); err != nil {
+			return nil, fmt.Errorf("unknown record type '%s' in attribute '%s'", rtype, rateKey(k))
+		}
+		tr, ok := s.rates[rtype]
+		if !ok {
+			tr = &typeRates{rate: 1}
+			s.rates[rtype] = tr
+		}
+		if opFlag == "" {
+			tr.rate = rate
+			continue
+		}
+		bit, ok := opFlagBits[strings.ToUpper(opFlag)]
+		if !ok {
+			return nil, fmt.Errorf("unknown opflag '%s' in attribute '%s'", opFlag, rateKey(k))
+		}
+		tr.opFlags = append(tr.opFlags, opFlagRate{bit: bit, rate: rate})
+	}
+	if mode == HashSampling {
+		if len(key) == 0 {
+			return nil, fmt.Errorf("attribute '%s' lists no fields", SamplingKeyKey)
+		}
+		for _, attr := range key {
+			if !engine.Mapper.IsField(attr) {
+				return nil, fmt.Errorf("unknown field '%s' in attribute '%s'", attr, SamplingKeyKey)
+			}
+			s.key = append(s.key, engine.Mapper.MapStr(attr))
+		}
+	}
+	return s, nil
+}
+
+func rateKey(k string) string {
+	return SamplingRateKey + "." + k
+}
+
+// Keep checks whether record fr is kept by the sampler, counting the records sampled out.
+func (s *Sampler) Keep(fr *sfgo.FlatRecord) bool {
+	r := &engine.Record{Fr: *fr}
+	rtype := engine.Mapper.MapStr(engine.SF_TYPE)(r)
+	tr, ok := s.rates[rtype]
+	if !ok {
+		return true
+	}
+	rate := tr.rate
+	if len(tr.opFlags) > 0 {
+		opFlags := fr.Ints[sfgo.SYSFLOW_IDX][sfgo.OPFLAGS_INT]
+		matched := false
+		for _, of := range tr.opFlags {
+			if opFlags&of.bit != 0 && (!matched || of.rate > rate) {
+				rate = of.rate
+				matched = true
+			}
+		}
+	}
+	if rate >= 1 {
+		return true
+	}
+	var p float64
+	if s.mode == HashSampling {
+		p = float64(s.hash(r)) / math.MaxUint64
+	} else {
+		p = s.rnd.Float64()
+	}
+	if p < rate {
+		return true
+	}
+	s.dropped[rtype]++
+	return false
+}
+
+// hash computes a hash value over the sampling key of record r.
+func (s *Sampler) hash(r *engine.Record) uint64 {
+	h := xxhash.New()
+	for _, m := range s.key {
+		h.WriteString(m(r))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// Dropped returns the number of records sampled out, keyed by record type.
+func (s *Sampler) Dropped() map[string]uint64 {
+	dropped := make(map[string]uint64, len(s.dropped))
+	for k, v := range s.dropped {
+		dropped[k] = v
+	}
+	return dropped
+}
+
+// String returns the number of records sampled out per record type.
+func (s *Sampler) String() string {
+	var types []string
+	for k := range s.dropped {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	var sb strings.Builder
+	for i, k := range types {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s: %d", k, s.dropped[k])
+	}
+	return sb.String()
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package flattener
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func newSampledFlow(rtype int64, opFlags int64, pid int64) *sfgo.FlatRecord {
+	fr := newFlatRecord()
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = rtype
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.OPFLAGS_INT] = opFlags
+	fr.Ints[sfgo.SYSFLOW_IDX][sfgo.PROC_OID_HPID_INT] = pid
+	fr.Strs[sfgo.SYSFLOW_IDX][sfgo.SFHE_EXPORTER_STR] = "node1"
+	return fr
+}
+
+func TestSampler(t *testing.T) {
+	conf, _ := CreateConfig(map[string]interface{}{"sampling.rate.FF": "0.5", "sampling.rate.FF.READ": "0.1", "sampling.rate.FF.WRITE": "1", "sampling.rate.PE": "1.0"})
+	assert.Equal(t, map[string]float64{"FF": 0.5, "FF.READ": 0.1, "FF.WRITE": 1, "PE": 1}, conf.SamplingRates)
+	assert.Equal(t, HashSampling, conf.SamplingMode)
+	assert.Equal(t, time.Minute, conf.SamplingStatsInt)
+	s, err := NewSampler(conf.SamplingRates, conf.SamplingKey, conf.SamplingMode)
+	if !assert.NoError(t, err) {
+		return
+	}
+	const n = 10000
+	counts := make(map[string]int)
+	for pid := int64(0); pid < n; pid++ {
+		for name, fr := range map[string]*sfgo.FlatRecord{
+			"read":      newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_OPEN|sfgo.OP_READ_RECV|sfgo.OP_CLOSE, pid),
+			"readwrite": newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_READ_RECV|sfgo.OP_WRITE_SEND, pid),
+			"open":      newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_OPEN, pid),
+			"exec":      newSampledFlow(sfgo.PROC_EVT, sfgo.OP_EXEC, pid),
+			"flow":      newSampledFlow(sfgo.NET_FLOW, sfgo.OP_CONNECT, pid),
+		} {
+			if s.Keep(fr) {
+				counts[name]++
+			}
+		}
+	}
+	assert.InDelta(t, 0.1*n, counts["read"], 0.02*n)
+	assert.InDelta(t, 0.5*n, counts["open"], 0.02*n)
+	assert.Equal(t, n, counts["readwrite"], "the highest rate among opflags applies")
+	assert.Equal(t, n, counts["exec"])
+	assert.Equal(t, n, counts["flow"], "record types without rates are kept")
+	assert.Equal(t, map[string]uint64{"FF": uint64(2*n - counts["read"] - counts["open"])}, s.Dropped())
+
+	// records of a sampled process are kept or dropped together
+	for pid := int64(0); pid < 100; pid++ {
+		a := s.Keep(newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_OPEN, pid))
+		assert.Equal(t, a, s.Keep(newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_OPEN|sfgo.OP_CLOSE, pid)))
+	}
+
+	conf, _ = CreateConfig(map[string]interface{}{"sampling.rate.FF": "0.25", "sampling.mode": "random", "sampling.stats.interval": "10"})
+	assert.Equal(t, RandomSampling, conf.SamplingMode)
+	assert.Equal(t, 10*time.Second, conf.SamplingStatsInt)
+	s, err = NewSampler(conf.SamplingRates, conf.SamplingKey, conf.SamplingMode)
+	if !assert.NoError(t, err) {
+		return
+	}
+	kept := 0
+	for i := 0; i < n; i++ {
+		if s.Keep(newSampledFlow(sfgo.FILE_FLOW, sfgo.OP_OPEN, 1)) {
+			kept++
+		}
+	}
+	assert.InDelta(t, 0.25*n, kept, 0.02*n)
+
+	for _, conf := range []map[string]interface{}{
+		{"sampling.rate.FF": "1.5"},
+		{"sampling.rate.FF": "half"},
+		{"sampling.rate.XX": "0.5"},
+		{"sampling.rate.FF.FOO": "0.5"},
+		{"sampling.rate.FF": "0.5", "sampling.key": "sf.no.such.field"},
+		{"sampling.rate.FF": "0.5", "sampling.key": ""},
+	} {
+		c, _ := CreateConfig(conf)
+		_, err := NewSampler(c.SamplingRates, c.SamplingKey, c.SamplingMode)
+		assert.Error(t, err, fmt.Sprint(conf))
+	}
+}
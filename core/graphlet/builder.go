@@ -0,0 +1,257 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphlet builds process provenance graphs from SysFlow records.
+package graphlet
+
+import (
+	"sort"
+
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+const (
+	handlerName string = "graphlet"
+	channelName string = "graphletchan"
+)
+
+// sweepInterval is the trace time between two checks for inactive graphlets.
+const sweepInterval int64 = 1e9
+
+// Channel defines a graphlet channel.
+type Channel struct {
+	In chan *Graphlet
+}
+
+// NewGraphletChan creates a new channel with given capacity.
+func NewGraphletChan(size int) interface{} {
+	return &Channel{In: make(chan *Graphlet, size)}
+}
+
+// Builder defines a handler that assembles the records of each container into graphlets,
+// and emits a graphlet when no records have been added to it for the configured timeout,
+// measured in trace time.
+type Builder struct {
+	config    Config
+	open      map[string]*Graphlet
+	now       int64
+	lastSweep int64
+	outCh     []chan *Graphlet
+}
+
+// NewBuilder creates a new Builder instance.
+func NewBuilder() plugins.SFHandler {
+	return new(Builder)
+}
+
+// RegisterChannel registers channels to plugin cache.
+func (s *Builder) RegisterChannel(pc plugins.SFPluginCache) {
+	pc.AddChannel(channelName, NewGraphletChan)
+}
+
+// RegisterHandler registers handler to handler cache.
+func (s *Builder) RegisterHandler(hc plugins.SFHandlerCache) {
+	hc.AddHandler(handlerName, NewBuilder)
+}
+
+// Init initializes the handler with a configuration map.
+func (s *Builder) Init(conf map[string]interface{}) (err error) {
+	if s.config, err = CreateConfig(conf); err != nil {
+		return
+	}
+	s.open = make(map[string]*Graphlet)
+	return
+}
+
+// IsEntityEnabled is used to check if the builder handles entity records.
+func (s *Builder) IsEntityEnabled() bool {
+	return false
+}
+
+// SetOutChan sets the plugin output channel.
+func (s *Builder) SetOutChan(chObj []interface{}) {
+	for _, ch := range chObj {
+		s.outCh = append(s.outCh, ch.(*Channel).In)
+	}
+}
+
+// Cleanup emits the open graphlets and tears down resources.
+func (s *Builder) Cleanup() {
+	logger.Trace.Println("Calling Cleanup on graphlet builder channel")
+	s.close(func(g *Graphlet) bool { return true })
+	for _, ch := range s.outCh {
+		close(ch)
+	}
+}
+
+// graphlet returns the graphlet of a record's container and the node of its process, after
+// emitting the graphlets that have been inactive for longer than the timeout.
+func (s *Builder) graphlet(sf *plugins.CtxSysFlow, ts int64) (*Graphlet, *ProcNode) {
+	if ts > s.now {
+		s.now = ts
+	}
+	if s.now-s.lastSweep >= sweepInterval {
+		s.lastSweep = s.now
+		timeout := s.config.Timeout.Nanoseconds()
+		s.close(func(g *Graphlet) bool { return s.now-g.EndTs > timeout })
+	}
+	if sf.Process == nil || sf.Process.Oid == nil {
+		return nil, nil
+	}
+	var node string
+	if sf.Header != nil {
+		node = sf.Header.Exporter
+	}
+	contID := procContID(sf.Process)
+	key := node + "/" + contID
+	g, ok := s.open[key]
+	if !ok {
+		cont := sf.Container
+		if contID == "" {
+			cont = nil
+		}
+		g = newGraphlet(node, cont, ts)
+		s.open[key] = g
+	}
+	g.touch(ts)
+	ptree := sf.PTree
+	if len(ptree) == 0 {
+		ptree = []*sfgo.Process{sf.Process}
+	}
+	return g, g.addPtree(ptree, contID)
+}
+
+// done emits a graphlet that reached the maximum size.
+func (s *Builder) done(g *Graphlet) {
+	if s.config.MaxSize > 0 && g.size() >= s.config.MaxSize {
+		s.close(func(o *Graphlet) bool { return o == g })
+	}
+}
+
+// close emits the open graphlets matching a predicate, in the order of their start times.
+func (s *Builder) close(pred func(g *Graphlet) bool) {
+	var closed []*Graphlet
+	for key, g := range s.open {
+		if pred(g) {
+			closed = append(closed, g)
+			delete(s.open, key)
+		}
+	}
+	sort.Slice(closed, func(i, j int) bool {
+		if closed[i].Ts != closed[j].Ts {
+			return closed[i].Ts < closed[j].Ts
+		}
+		return closed[i].ID < closed[j].ID
+	})
+	for _, g := range closed {
+		g.finalize()
+		for _, ch := range s.outCh {
+			ch <- g
+		}
+	}
+}
+
+// HandleHeader processes Header entities.
+func (s *Builder) HandleHeader(sf *plugins.CtxSysFlow, hdr *sfgo.SFHeader) error {
+	return nil
+}
+
+// HandleContainer processes Container entities.
+func (s *Builder) HandleContainer(sf *plugins.CtxSysFlow, cont *sfgo.Container) error {
+	return nil
+}
+
+// HandlePod processes Pod entities.
+func (s *Builder) HandlePod(sf *plugins.CtxSysFlow, cont *sfgo.Pod) error {
+	return nil
+}
+
+// HandleK8sEvt processes K8s Events.
+func (s *Builder) HandleK8sEvt(sf *plugins.CtxSysFlow, ke *sfgo.K8sEvent) error {
+	return nil
+}
+
+// HandleProcess processes Process entities.
+func (s *Builder) HandleProcess(sf *plugins.CtxSysFlow, proc *sfgo.Process) error {
+	return nil
+}
+
+// HandleFile processes File entities.
+func (s *Builder) HandleFile(sf *plugins.CtxSysFlow, file *sfgo.File) error {
+	return nil
+}
+
+// HandleNetFlow processes Network Flows.
+func (s *Builder) HandleNetFlow(sf *plugins.CtxSysFlow, nf *sfgo.NetworkFlow) error {
+	if g, _ := s.graphlet(sf, maxTs(nf.Ts, nf.EndTs)); g != nil {
+		g.addFlow(sf.Process, nf.Sip, nf.Sport, nf.Dip, nf.Dport, nf.Proto, nf.OpFlags, nf.Ts, nf.EndTs, nf.NumRRecvBytes, nf.NumWSendBytes)
+		s.done(g)
+	}
+	return nil
+}
+
+// HandleNetEvt processes Network Events.
+func (s *Builder) HandleNetEvt(sf *plugins.CtxSysFlow, ne *sfgo.NetworkEvent) error {
+	if g, _ := s.graphlet(sf, ne.Ts); g != nil {
+		g.addFlow(sf.Process, ne.Sip, ne.Sport, ne.Dip, ne.Dport, ne.Proto, ne.OpFlags, ne.Ts, ne.Ts, 0, 0)
+		s.done(g)
+	}
+	return nil
+}
+
+// HandleFileFlow processes File Flows.
+func (s *Builder) HandleFileFlow(sf *plugins.CtxSysFlow, ff *sfgo.FileFlow) error {
+	if g, _ := s.graphlet(sf, maxTs(ff.Ts, ff.EndTs)); g != nil && sf.File != nil {
+		g.addFile(sf.Process, sf.File.Path, ff.OpFlags, ff.Ts, ff.EndTs, ff.NumRRecvBytes, ff.NumWSendBytes)
+		s.done(g)
+	}
+	return nil
+}
+
+// HandleFileEvt processes File Events.
+func (s *Builder) HandleFileEvt(sf *plugins.CtxSysFlow, fe *sfgo.FileEvent) error {
+	if g, _ := s.graphlet(sf, fe.Ts); g != nil && sf.File != nil {
+		g.addFile(sf.Process, sf.File.Path, fe.OpFlags, fe.Ts, fe.Ts, 0, 0)
+		if sf.NewFile != nil {
+			g.addFile(sf.Process, sf.NewFile.Path, fe.OpFlags, fe.Ts, fe.Ts, 0, 0)
+		}
+		s.done(g)
+	}
+	return nil
+}
+
+// HandleProcFlow processes Process Flows.
+func (s *Builder) HandleProcFlow(sf *plugins.CtxSysFlow, pf *sfgo.ProcessFlow) error {
+	if g, n := s.graphlet(sf, maxTs(pf.Ts, pf.EndTs)); g != nil {
+		n.opFlags |= pf.OpFlags
+		s.done(g)
+	}
+	return nil
+}
+
+// HandleProcEvt processes Process Events.
+func (s *Builder) HandleProcEvt(sf *plugins.CtxSysFlow, pe *sfgo.ProcessEvent) error {
+	if g, n := s.graphlet(sf, pe.Ts); g != nil {
+		n.opFlags |= pe.OpFlags
+		s.done(g)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package graphlet
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func newProc(pid int64, ppid int64, cont string, exe string) *sfgo.Process {
+	p := &sfgo.Process{Oid: &sfgo.OID{Hpid: pid, CreateTS: pid}, Exe: exe}
+	if ppid > 0 {
+		p.Poid = &sfgo.PoidUnion{UnionType: sfgo.PoidUnionTypeEnumOID, OID: &sfgo.OID{Hpid: ppid, CreateTS: ppid}}
+	}
+	if cont != "" {
+		p.ContainerId = &sfgo.ContainerIdUnion{UnionType: sfgo.ContainerIdUnionTypeEnumString, String: cont}
+	}
+	return p
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder().(*Builder)
+	assert.NoError(t, b.Init(map[string]interface{}{TimeoutKey: "10"}))
+	ch := NewGraphletChan(10).(*Channel)
+	b.SetOutChan([]interface{}{ch})
+
+	hdr := &sfgo.SFHeader{Exporter: "node1"}
+	cont := &sfgo.Container{Id: "c1", Name: "web", Image: "nginx"}
+	shim := newProc(1, 0, "", "/usr/bin/containerd-shim")
+	nginx := newProc(2, 1, "c1", "/usr/sbin/nginx")
+	sh := newProc(3, 2, "c1", "/bin/sh")
+	ctx := func(p ...*sfgo.Process) *plugins.CtxSysFlow {
+		return &plugins.CtxSysFlow{Header: hdr, Container: cont, Process: p[0], PTree: p}
+	}
+	sec := int64(time.Second)
+	// sfgo caches rendered flags by value, so render the file flow's flags as network flags first
+	sfgo.GetOpFlags(sfgo.OP_OPEN|sfgo.OP_READ_RECV, sfgo.SF_NET_FLOW)
+
+	b.HandleProcEvt(ctx(sh, nginx, shim), &sfgo.ProcessEvent{Ts: sec, OpFlags: sfgo.OP_EXEC})
+	fc := ctx(sh, nginx, shim)
+	fc.File = &sfgo.File{Path: "/etc/passwd"}
+	b.HandleFileFlow(fc, &sfgo.FileFlow{Ts: 2 * sec, EndTs: 3 * sec, OpFlags: sfgo.OP_OPEN | sfgo.OP_READ_RECV, NumRRecvBytes: 100})
+	b.HandleFileFlow(fc, &sfgo.FileFlow{Ts: 4 * sec, OpFlags: sfgo.OP_READ_RECV, NumRRecvBytes: 20})
+	b.HandleNetFlow(ctx(nginx, shim), &sfgo.NetworkFlow{Ts: 4 * sec, Sip: 0x0100007f, Sport: 80, Dip: 0x0200000a, Dport: 4444, Proto: 6, OpFlags: sfgo.OP_ACCEPT})
+	b.HandleProcEvt(ctx(shim), &sfgo.ProcessEvent{Ts: 5 * sec, OpFlags: sfgo.OP_CLONE})
+	assert.Len(t, ch.In, 0)
+
+	// the container graphlet is closed after 10s of inactivity
+	b.HandleProcEvt(ctx(shim), &sfgo.ProcessEvent{Ts: 15 * sec, OpFlags: sfgo.OP_CLONE})
+	if assert.Len(t, ch.In, 1) {
+		g := <-ch.In
+		assert.Equal(t, "node1", g.Node)
+		assert.Equal(t, "web", g.Container.Name)
+		assert.Equal(t, sec, g.Ts)
+		assert.Equal(t, 4*sec, g.EndTs)
+		if assert.Len(t, g.Procs, 2) {
+			assert.Equal(t, "", g.Procs[0].Parent, "host ancestors are not part of container graphlets")
+			assert.Equal(t, "2-2", g.Procs[1].Parent)
+			assert.Equal(t, []string{sfgo.OpFlagExec}, g.Procs[1].OpFlags)
+		}
+		if assert.Len(t, g.Files, 1) {
+			assert.Equal(t, int64(120), g.Files[0].RBytes)
+			assert.Equal(t, 4*sec, g.Files[0].EndTs)
+			assert.Equal(t, []string{sfgo.OpFlagOpen, sfgo.OpFlagRead}, g.Files[0].OpFlags)
+		}
+		if assert.Len(t, g.Flows, 1) {
+			assert.Equal(t, "10.0.0.2", g.Flows[0].DIP)
+			assert.Equal(t, "tcp", g.Flows[0].Proto)
+			assert.Equal(t, []string{sfgo.OpFlagAccept}, g.Flows[0].OpFlags)
+		}
+		assert.Contains(t, string(g.DOT()), `"2-2" -> "3-3";`)
+		assert.Contains(t, string(g.DOT()), `[shape=note,label="/etc/passwd"]`)
+		_, err := json.Marshal(g)
+		assert.NoError(t, err)
+	}
+
+	// open graphlets are emitted on cleanup
+	b.Cleanup()
+	if g, ok := <-ch.In; assert.True(t, ok) {
+		assert.Nil(t, g.Container)
+		assert.Len(t, g.Procs, 1)
+	}
+	_, ok := <-ch.In
+	assert.False(t, ok)
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphlet builds process provenance graphs from SysFlow records.
+package graphlet
+
+import (
+	"strconv"
+	"time"
+)
+
+// Configuration keys.
+const (
+	TimeoutKey string = "graphlet.timeout"
+	MaxSizeKey string = "graphlet.maxsize"
+)
+
+// Config defines a configuration object for the graphlet builder.
+type Config struct {
+	Timeout time.Duration
+	MaxSize int
+}
+
+// CreateConfig creates a new config object from config dictionary.
+func CreateConfig(conf map[string]interface{}) (Config, error) {
+	var c Config = Config{Timeout: time.Minute, MaxSize: 10000} // default values
+	var err error
+	if v, ok := conf[TimeoutKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.Timeout = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[MaxSizeKey].(string); ok {
+		c.MaxSize, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,310 @@
+//
+// Copyright (C) 2022 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphlet builds process provenance graphs from SysFlow records.
+package graphlet
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+// Graphlet is the provenance graph of the processes of a container (or of the host, for processes
+// running outside containers), with the files and network endpoints the processes interacted with.
+type Graphlet struct {
+	ID        uint64      `json:"id"`
+	Node      string      `json:"node"`
+	Container *Container  `json:"container,omitempty"`
+	Ts        int64       `json:"ts"`
+	EndTs     int64       `json:"endts"`
+	Procs     []*ProcNode `json:"procs"`
+	Files     []*FileEdge `json:"files"`
+	Flows     []*NetEdge  `json:"flows"`
+
+	procs map[sfgo.OID]*ProcNode
+	files map[fileKey]*FileEdge
+	flows map[netKey]*NetEdge
+}
+
+// Container describes the container of a graphlet.
+type Container struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+// ProcNode is a process of a graphlet, linked to its parent process if the parent is part of the graphlet.
+type ProcNode struct {
+	ID      string   `json:"id"`
+	Parent  string   `json:"parent,omitempty"`
+	Exe     string   `json:"exe"`
+	Args    string   `json:"args"`
+	UID     int32    `json:"uid"`
+	User    string   `json:"user"`
+	Tty     bool     `json:"tty"`
+	Ts      int64    `json:"ts"`
+	OpFlags []string `json:"opflags,omitempty"`
+
+	opFlags int32
+}
+
+// FileEdge aggregates the file flows and events of a process on a file.
+type FileEdge struct {
+	Proc    string   `json:"proc"`
+	Path    string   `json:"path"`
+	OpFlags []string `json:"opflags"`
+	Ts      int64    `json:"ts"`
+	EndTs   int64    `json:"endts"`
+	RBytes  int64    `json:"rbytes"`
+	WBytes  int64    `json:"wbytes"`
+
+	opFlags int32
+}
+
+// NetEdge aggregates the network flows and events of a process on a connection.
+type NetEdge struct {
+	Proc    string   `json:"proc"`
+	SIP     string   `json:"sip"`
+	SPort   int32    `json:"sport"`
+	DIP     string   `json:"dip"`
+	DPort   int32    `json:"dport"`
+	Proto   string   `json:"proto"`
+	OpFlags []string `json:"opflags"`
+	Ts      int64    `json:"ts"`
+	EndTs   int64    `json:"endts"`
+	RBytes  int64    `json:"rbytes"`
+	WBytes  int64    `json:"wbytes"`
+
+	opFlags int32
+}
+
+type fileKey struct {
+	proc sfgo.OID
+	path string
+}
+
+type netKey struct {
+	proc                   sfgo.OID
+	sip, sport, dip, dport int32
+	proto                  int32
+}
+
+func newGraphlet(node string, cont *sfgo.Container, ts int64) *Graphlet {
+	g := &Graphlet{
+		Node:  node,
+		Ts:    ts,
+		EndTs: ts,
+		Procs: []*ProcNode{},
+		Files: []*FileEdge{},
+		Flows: []*NetEdge{},
+		procs: make(map[sfgo.OID]*ProcNode),
+		files: make(map[fileKey]*FileEdge),
+		flows: make(map[netKey]*NetEdge),
+	}
+	h := xxhash.New()
+	h.WriteString(node)
+	if cont != nil {
+		g.Container = &Container{ID: cont.Id, Name: cont.Name, Image: cont.Image}
+		h.WriteString(cont.Id)
+	}
+	fmt.Fprint(h, ts)
+	g.ID = h.Sum64()
+	return g
+}
+
+// size returns the number of nodes and edges of the graphlet.
+func (g *Graphlet) size() int {
+	return len(g.Procs) + len(g.Files) + len(g.Flows)
+}
+
+// touch extends the time span of the graphlet.
+func (g *Graphlet) touch(ts int64) {
+	if ts > g.EndTs {
+		g.EndTs = ts
+	}
+}
+
+// addPtree adds a process and those of its ancestors that run in the same container, and
+// returns the node of the process.
+func (g *Graphlet) addPtree(ptree []*sfgo.Process, contID string) *ProcNode {
+	var node *ProcNode
+	for i := len(ptree) - 1; i >= 0; i-- {
+		p := ptree[i]
+		if p.Oid == nil || (i > 0 && procContID(p) != contID) {
+			continue
+		}
+		n, ok := g.procs[*p.Oid]
+		if !ok {
+			n = &ProcNode{ID: oidStr(p.Oid), Ts: p.Ts}
+			g.procs[*p.Oid] = n
+			g.Procs = append(g.Procs, n)
+		}
+		// processes may be modified, e.g., by an exec
+		n.Exe, n.Args, n.UID, n.User, n.Tty = p.Exe, p.ExeArgs, p.Uid, p.UserName, p.Tty
+		if poid := parentOID(p); poid != nil {
+			if _, ok := g.procs[*poid]; ok {
+				n.Parent = oidStr(poid)
+			}
+		}
+		node = n
+	}
+	return node
+}
+
+func (g *Graphlet) addFile(proc *sfgo.Process, path string, opFlags int32, ts, endTs, rbytes, wbytes int64) {
+	k := fileKey{proc: *proc.Oid, path: path}
+	e, ok := g.files[k]
+	if !ok {
+		e = &FileEdge{Proc: oidStr(proc.Oid), Path: path, Ts: ts}
+		g.files[k] = e
+		g.Files = append(g.Files, e)
+	}
+	e.opFlags |= opFlags
+	e.EndTs = maxTs(e.EndTs, ts, endTs)
+	e.RBytes += rbytes
+	e.WBytes += wbytes
+}
+
+func (g *Graphlet) addFlow(proc *sfgo.Process, sip, sport, dip, dport, proto int32, opFlags int32, ts, endTs, rbytes, wbytes int64) {
+	k := netKey{proc: *proc.Oid, sip: sip, sport: sport, dip: dip, dport: dport, proto: proto}
+	e, ok := g.flows[k]
+	if !ok {
+		e = &NetEdge{
+			Proc:  oidStr(proc.Oid),
+			SIP:   sfgo.GetIPStr(sip),
+			SPort: sport,
+			DIP:   sfgo.GetIPStr(dip),
+			DPort: dport,
+			Proto: sfgo.GetProto(int64(proto)),
+			Ts:    ts,
+		}
+		g.flows[k] = e
+		g.Flows = append(g.Flows, e)
+	}
+	e.opFlags |= opFlags
+	e.EndTs = maxTs(e.EndTs, ts, endTs)
+	e.RBytes += rbytes
+	e.WBytes += wbytes
+}
+
+// finalize renders the operation flags of the graphlet's nodes and edges.
+func (g *Graphlet) finalize() {
+	for _, n := range g.Procs {
+		if n.opFlags != 0 {
+			n.OpFlags = sfgo.GetOpFlags(n.opFlags, sfgo.SF_PROC_EVT)
+		}
+	}
+	for _, e := range g.Files {
+		e.OpFlags = ioOpFlags(e.opFlags, sfgo.OpFlagWrite, sfgo.OpFlagRead)
+	}
+	for _, e := range g.Flows {
+		e.OpFlags = ioOpFlags(e.opFlags, sfgo.OpFlagSend, sfgo.OpFlagReceive)
+	}
+}
+
+// ioOpFlags renders the operation flags of an edge with the given names for writes and reads.
+// sfgo caches rendered flags regardless of the record type, so the read and write flags are
+// not rendered by sfgo.
+func ioOpFlags(opFlags int32, write string, read string) []string {
+	ops := append([]string{}, sfgo.GetOpFlags(opFlags&^(sfgo.OP_WRITE_SEND|sfgo.OP_READ_RECV), sfgo.SF_FILE_FLOW)...)
+	if opFlags&sfgo.OP_WRITE_SEND == sfgo.OP_WRITE_SEND {
+		ops = append(ops, write)
+	}
+	if opFlags&sfgo.OP_READ_RECV == sfgo.OP_READ_RECV {
+		ops = append(ops, read)
+	}
+	return ops
+}
+
+// DOT renders the graphlet in the Graphviz DOT language.
+func (g *Graphlet) DOT() []byte {
+	var b bytes.Buffer
+	label := g.Node
+	if g.Container != nil {
+		label = fmt.Sprintf("%s: %s (%s)", g.Node, g.Container.Name, g.Container.Image)
+	}
+	fmt.Fprintf(&b, "digraph \"%d\" {\n", g.ID)
+	fmt.Fprintf(&b, "  label=\"%s\";\n", dotEscape(label))
+	for _, n := range g.Procs {
+		fmt.Fprintf(&b, "  \"%s\" [shape=box,label=\"%s\\n%s\"];\n", n.ID, dotEscape(n.Exe), dotEscape(n.Args))
+		if n.Parent != "" {
+			fmt.Fprintf(&b, "  \"%s\" -> \"%s\";\n", n.Parent, n.ID)
+		}
+	}
+	paths := make(map[string]int)
+	for _, e := range g.Files {
+		i, ok := paths[e.Path]
+		if !ok {
+			i = len(paths)
+			paths[e.Path] = i
+			fmt.Fprintf(&b, "  \"f%d\" [shape=note,label=\"%s\"];\n", i, dotEscape(e.Path))
+		}
+		fmt.Fprintf(&b, "  \"%s\" -> \"f%d\" [label=\"%s\"];\n", e.Proc, i, strings.Join(e.OpFlags, " "))
+	}
+	endpoints := make(map[string]int)
+	for _, e := range g.Flows {
+		ep := fmt.Sprintf("%s:%d/%s", e.DIP, e.DPort, e.Proto)
+		i, ok := endpoints[ep]
+		if !ok {
+			i = len(endpoints)
+			endpoints[ep] = i
+			fmt.Fprintf(&b, "  \"n%d\" [shape=diamond,label=\"%s\"];\n", i, ep)
+		}
+		fmt.Fprintf(&b, "  \"%s\" -> \"n%d\" [label=\"%s\"];\n", e.Proc, i, strings.Join(e.OpFlags, " "))
+	}
+	b.WriteString("}")
+	return b.Bytes()
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func dotEscape(s string) string {
+	return dotEscaper.Replace(s)
+}
+
+func oidStr(oid *sfgo.OID) string {
+	return fmt.Sprintf("%d-%d", oid.Hpid, oid.CreateTS)
+}
+
+func parentOID(p *sfgo.Process) *sfgo.OID {
+	if p.Poid != nil && p.Poid.UnionType == sfgo.PoidUnionTypeEnumOID {
+		return p.Poid.OID
+	}
+	return nil
+}
+
+func procContID(p *sfgo.Process) string {
+	if p.ContainerId != nil && p.ContainerId.UnionType == sfgo.ContainerIdUnionTypeEnumString {
+		return p.ContainerId.String
+	}
+	return ""
+}
+
+func maxTs(ts ...int64) (m int64) {
+	for _, t := range ts {
+		if t > m {
+			m = t
+		}
+	}
+	return
+}
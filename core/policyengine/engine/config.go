@@ -22,7 +22,9 @@
 package engine
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -37,6 +39,13 @@ const (
 	MonitorIntervalKey   string = "monitor.interval"
 	ConcurrencyKey       string = "concurrency"
 	ActionDirKey         string = "actiondir"
+	AlertChannelKey      string = "alert.channel"
+	OutChannelsKey       string = "out"
+	RoutePrefixKey       string = "route."
+	ExplainKey           string = "explain"
+	CoverageKey          string = "coverage"
+	ShadowKey            string = "shadow"
+	ShadowIntervalKey    string = "shadow.interval"
 )
 
 // Config defines a configuration object for the engine.
@@ -50,11 +59,19 @@ type Config struct {
 	MonitorInterval   time.Duration
 	Concurrency       int
 	ActionDir         string
+	AlertChannel      string
+	OutChannels       []string
+	Routes            []Route
+	Explain           bool
+	Coverage          string
+	ShadowPath        string
+	ShadowInterval    time.Duration
+	Shadow            bool
 }
 
 // CreateConfig creates a new config object from config dictionary.
 func CreateConfig(conf map[string]interface{}) (Config, error) {
-	var c Config = Config{Mode: AlertMode, Concurrency: 5, Monitor: NoneType, MonitorInterval: 30 * time.Second, ActionDir: "../resources/actions"} // default values
+	var c Config = Config{Mode: AlertMode, Concurrency: 5, Monitor: NoneType, MonitorInterval: 30 * time.Second, ShadowInterval: 60 * time.Second, ActionDir: "../resources/actions"} // default values
 	var err error
 
 	if v, ok := conf[PoliciesConfigKey].(string); ok {
@@ -83,12 +100,54 @@ func CreateConfig(conf map[string]interface{}) (Config, error) {
 		}
 	}
 	if v, ok := conf[ConcurrencyKey].(string); ok {
-		c.Concurrency, err = strconv.Atoi(v)
+		if c.Concurrency, err = strconv.Atoi(v); err != nil || c.Concurrency <= 0 {
+			return c, fmt.Errorf("attribute '%s' must be a positive number", ConcurrencyKey)
+		}
 	}
 	if v, ok := conf[ActionDirKey].(string); ok {
 		c.ActionDir = v
 	}
-	return c, err
+	if v, ok := conf[AlertChannelKey].(string); ok {
+		c.AlertChannel = v
+	}
+	if v, ok := conf[OutChannelsKey]; ok {
+		c.OutChannels = parseChannelNames(v)
+	}
+	if v, ok := conf[ExplainKey].(string); ok {
+		if c.Explain, err = strconv.ParseBool(v); err != nil {
+			return c, fmt.Errorf("attribute '%s' must be a boolean (true or false)", ExplainKey)
+		}
+	}
+	if v, ok := conf[CoverageKey].(string); ok {
+		c.Coverage = v
+	}
+	if v, ok := conf[ShadowKey].(string); ok {
+		c.ShadowPath = v
+	}
+	if v, ok := conf[ShadowIntervalKey].(string); ok {
+		var duration int
+		if duration, err = strconv.Atoi(v); err != nil || duration <= 0 {
+			return c, fmt.Errorf("attribute '%s' must be a positive number of seconds", ShadowIntervalKey)
+		}
+		c.ShadowInterval = time.Duration(duration) * time.Second
+	}
+	if c.Routes, err = parseRoutes(conf); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// ShadowConfig returns the configuration of an interpreter for the shadow policies. Shadow interpreters
+// load the policies in ShadowPath and run in alert mode, without explanations, coverage or rule actions,
+// so that evaluating candidate policies has no side effects.
+func (c Config) ShadowConfig() Config {
+	conf := c
+	conf.PoliciesPath = c.ShadowPath
+	conf.Mode = AlertMode
+	conf.Explain = false
+	conf.Coverage = ""
+	conf.Shadow = true
+	return conf
 }
 
 // Mode type.
@@ -98,10 +157,11 @@ type Mode int
 const (
 	EnrichMode Mode = iota
 	AlertMode
+	DualMode
 )
 
 func (s Mode) String() string {
-	return [...]string{"enrich", "alert"}[s]
+	return [...]string{"enrich", "alert", "dual"}[s]
 }
 
 func parseModeConfig(s string) Mode {
@@ -111,9 +171,31 @@ func parseModeConfig(s string) Mode {
 	if AlertMode.String() == s {
 		return AlertMode
 	}
+	if DualMode.String() == s {
+		return DualMode
+	}
 	return EnrichMode
 }
 
+// parseChannelNames extracts the channel identifiers from a pipeline channel declaration.
+// Channels are declared as '<identifier> <type>', either as a single string or as a list.
+func parseChannelNames(v interface{}) []string {
+	var names []string
+	switch t := v.(type) {
+	case string:
+		if fields := strings.Fields(t); len(fields) > 0 {
+			names = append(names, fields[0])
+		}
+	case []interface{}:
+		for _, ch := range t {
+			if s, ok := ch.(string); ok {
+				names = append(names, parseChannelNames(s)...)
+			}
+		}
+	}
+	return names
+}
+
 // MonitorType defines a policy monitor type.
 type MonitorType uint32
 
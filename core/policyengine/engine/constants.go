@@ -26,6 +26,7 @@ const (
 	EMPTY   string = ""
 	QUOTE   string = "\""
 	SPACE   string = " "
+	PATHSEP string = "/"
 )
 
 // Falco priority values.
@@ -66,6 +67,8 @@ const (
 	SF_PROC_AEXE            string = "sf.proc.aexe"
 	SF_PROC_ACMDLINE        string = "sf.proc.acmdline"
 	SF_PROC_APID            string = "sf.proc.apid"
+	SF_PROC_ADEPTH          string = "sf.proc.adepth"
+	SF_PROC_ANEAREST        string = "sf.proc.anearest"
 	SF_PPROC_OID            string = "sf.pproc.oid"
 	SF_PPROC_PID            string = "sf.pproc.pid"
 	SF_PPROC_NAME           string = "sf.pproc.name"
@@ -126,6 +129,14 @@ const (
 	SF_K8SE_ACTION          string = "sf.ke.action"
 	SF_K8SE_KIND            string = "sf.ke.kind"
 	SF_K8SE_MESSAGE         string = "sf.ke.message"
+	SF_PF_THREADSCLONED     string = "sf.pf.threadscloned"
+	SF_PF_THREADSEXITED     string = "sf.pf.threadsexited"
+	SF_PF_CLONEERRORS       string = "sf.pf.cloneerrors"
+	SF_DEDUP_COUNT          string = "sf.dedup.count"
+	SF_DEDUP_FIRSTTS        string = "sf.dedup.firstts"
+	SF_DEDUP_LASTTS         string = "sf.dedup.lastts"
+	SF_AGG_COUNT            string = "sf.agg.count"
+	SF_ENTITY_STATE         string = "sf.entity.state"
 	SF_NODE_ID              string = "sf.node.id"
 	SF_NODE_IP              string = "sf.node.ip"
 	SF_SCHEMA_VERSION       string = "sf.meta.schema"
@@ -0,0 +1,191 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync/atomic"
+	"text/tabwriter"
+
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
+)
+
+// PredicateCoverage counts how often a predicate of a rule evaluated true.
+type PredicateCoverage struct {
+	True  uint64
+	Macro string
+	Expr  string
+}
+
+// RuleCoverage counts how often a rule was evaluated and matched.
+type RuleCoverage struct {
+	Evaluated  uint64
+	Matched    uint64
+	Name       string
+	Predicates []*PredicateCoverage
+}
+
+// MacroCoverage counts how often a macro was evaluated and evaluated true.
+type MacroCoverage struct {
+	Evaluated uint64
+	True      uint64
+	Name      string
+}
+
+// Coverage collects statistics on the rules, macros and predicates of a policy
+// that evaluate true over a stream of records.
+type Coverage struct {
+	Rules  []*RuleCoverage
+	Macros map[string]*MacroCoverage
+
+	// Compilation state
+	rule   *RuleCoverage
+	macros []string
+}
+
+func newCoverage() *Coverage {
+	return &Coverage{Macros: make(map[string]*MacroCoverage)}
+}
+
+func (c *Coverage) addMacro(name string) {
+	if _, ok := c.Macros[name]; !ok {
+		c.Macros[name] = &MacroCoverage{Name: name}
+	}
+}
+
+func (c *Coverage) enterMacro(name string) {
+	c.macros = append(c.macros, name)
+}
+
+func (c *Coverage) exitMacro() {
+	c.macros = c.macros[:len(c.macros)-1]
+}
+
+// visitRule compiles the condition of rule name, instrumenting it if coverage is enabled.
+func (pi *PolicyInterpreter) visitRule(name string, ctx parser.IExpressionContext) Criterion {
+	if pi.cov == nil {
+		return pi.visitExpression(ctx)
+	}
+	rc := &RuleCoverage{Name: name}
+	pi.cov.Rules = append(pi.cov.Rules, rc)
+	pi.cov.rule = rc
+	defer func() { pi.cov.rule = nil }()
+	cond := pi.visitExpression(ctx)
+	p := func(r *Record) bool {
+		atomic.AddUint64(&rc.Evaluated, 1)
+		if cond.Eval(r) {
+			atomic.AddUint64(&rc.Matched, 1)
+			return true
+		}
+		return false
+	}
+	return Criterion{p}
+}
+
+// instrumentTerm wraps the criterion c compiled from term ctx with coverage counters.
+func (pi *PolicyInterpreter) instrumentTerm(ctx *parser.TermContext, c Criterion) Criterion {
+	if name, ok := macroRef(ctx); ok {
+		mc, ok := pi.cov.Macros[name]
+		if !ok {
+			return c
+		}
+		p := func(r *Record) bool {
+			atomic.AddUint64(&mc.Evaluated, 1)
+			if c.Eval(r) {
+				atomic.AddUint64(&mc.True, 1)
+				return true
+			}
+			return false
+		}
+		return Criterion{p}
+	}
+	if ctx.NOT() != nil || ctx.Expression() != nil || pi.cov.rule == nil {
+		return c
+	}
+	pc := &PredicateCoverage{Expr: termText(ctx)}
+	if n := len(pi.cov.macros); n > 0 {
+		pc.Macro = pi.cov.macros[n-1]
+	}
+	pi.cov.rule.Predicates = append(pi.cov.rule.Predicates, pc)
+	p := func(r *Record) bool {
+		if c.Eval(r) {
+			atomic.AddUint64(&pc.True, 1)
+			return true
+		}
+		return false
+	}
+	return Criterion{p}
+}
+
+// DeadMacros returns the sorted names of the macros that never evaluated true.
+func (c *Coverage) DeadMacros() []string {
+	var dead []string
+	for name, mc := range c.Macros {
+		if atomic.LoadUint64(&mc.True) == 0 {
+			dead = append(dead, name)
+		}
+	}
+	sort.Strings(dead)
+	return dead
+}
+
+// WriteReport writes a coverage report listing, for each rule, how often it was
+// evaluated and matched, and which of its predicates never evaluated true,
+// followed by the macros that never evaluated true.
+func (c *Coverage) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "RULE\tEVALUATED\tMATCHED")
+	for _, rc := range c.Rules {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", rc.Name, atomic.LoadUint64(&rc.Evaluated), atomic.LoadUint64(&rc.Matched))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, rc := range c.Rules {
+		var never []*PredicateCoverage
+		for _, pc := range rc.Predicates {
+			if atomic.LoadUint64(&pc.True) == 0 {
+				never = append(never, pc)
+			}
+		}
+		if len(never) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\nNever-true predicates in rule %q:\n", rc.Name)
+		for _, pc := range never {
+			if pc.Macro != "" {
+				fmt.Fprintf(w, "  %s (macro %s)\n", pc.Expr, pc.Macro)
+			} else {
+				fmt.Fprintf(w, "  %s\n", pc.Expr)
+			}
+		}
+	}
+	if dead := c.DeadMacros(); len(dead) > 0 {
+		fmt.Fprintln(w, "\nDead macros:")
+		for _, name := range dead {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
+)
+
+// DepNode holds the direct dependencies of a rule, filter, macro or list.
+type DepNode struct {
+	Macros  []string `json:"macros,omitempty"`
+	Lists   []string `json:"lists,omitempty"`
+	Fields  []string `json:"fields,omitempty"`
+	Affects []string `json:"affects,omitempty"`
+}
+
+// DepGraph is the dependency graph of a compiled policy, linking rules and filters
+// to the macros, lists and fields they reference.
+type DepGraph struct {
+	Rules   map[string]*DepNode `json:"rules"`
+	Filters map[string]*DepNode `json:"filters"`
+	Macros  map[string]*DepNode `json:"macros"`
+	Lists   map[string]*DepNode `json:"lists"`
+}
+
+// DependencyGraph builds the dependency graph of the compiled policies.
+func (pi *PolicyInterpreter) DependencyGraph() *DepGraph {
+	g := &DepGraph{
+		Rules:   make(map[string]*DepNode),
+		Filters: make(map[string]*DepNode),
+		Macros:  make(map[string]*DepNode),
+		Lists:   make(map[string]*DepNode),
+	}
+	for name, items := range pi.lists {
+		n := new(DepNode)
+		for _, item := range items {
+			if _, ok := pi.lists[item]; ok {
+				n.Lists = appendUnique(n.Lists, item)
+			}
+		}
+		g.Lists[name] = n
+	}
+	for name, ctx := range pi.macroCtxs {
+		g.Macros[name] = pi.dependencies(ctx)
+	}
+	for name, ctx := range pi.ruleCtxs {
+		g.Rules[name] = pi.dependencies(ctx)
+	}
+	for name, ctx := range pi.filterCtxs {
+		g.Filters[name] = pi.dependencies(ctx)
+	}
+	for name, n := range g.Macros {
+		n.Affects = g.Affected(name)
+	}
+	for name, n := range g.Lists {
+		n.Affects = g.Affected(name)
+	}
+	return g
+}
+
+// dependencies collects the macros, lists and fields referenced by an expression.
+func (pi *PolicyInterpreter) dependencies(ctx antlr.Tree) *DepNode {
+	n := new(DepNode)
+	var walk func(t antlr.Tree)
+	walk = func(t antlr.Tree) {
+		switch c := t.(type) {
+		case *parser.TermContext:
+			if name, args, ok := macroCall(c); ok {
+				n.Macros = appendUnique(n.Macros, name)
+				for _, a := range args {
+					walk(a)
+				}
+				return
+			}
+		case *parser.VariableContext:
+			if _, ok := pi.macroCtxs[c.GetText()]; ok {
+				n.Macros = appendUnique(n.Macros, c.GetText())
+			}
+			return
+		case *parser.AtomContext:
+			attr := c.GetText()
+			if _, ok := pi.lists[attr]; ok {
+				n.Lists = appendUnique(n.Lists, attr)
+			} else if Mapper.IsField(attr) {
+				n.Fields = appendUnique(n.Fields, attr)
+			}
+			return
+		}
+		for _, child := range t.GetChildren() {
+			walk(child)
+		}
+	}
+	walk(ctx)
+	sort.Strings(n.Macros)
+	sort.Strings(n.Lists)
+	sort.Strings(n.Fields)
+	return n
+}
+
+// Affected returns the sorted names of the rules and filters that depend, directly or
+// transitively, on the macro or list name.
+func (g *DepGraph) Affected(name string) []string {
+	// memo caches whether macros depend on name across searches, while visited holds the macros
+	// reached by the current search, which terminates recursive macros without caching their
+	// results until the search is complete.
+	memo := make(map[string]bool)
+	var visited map[string]bool
+	var depends func(n *DepNode) bool
+	depends = func(n *DepNode) bool {
+		for _, m := range n.Macros {
+			if m == name || g.macroDepends(m, depends, memo, visited) {
+				return true
+			}
+		}
+		for _, l := range n.Lists {
+			if l == name || g.listDepends(l, name, make(map[string]bool)) {
+				return true
+			}
+		}
+		return false
+	}
+	var affected []string
+	for _, nodes := range []map[string]*DepNode{g.Rules, g.Filters} {
+		for r, n := range nodes {
+			visited = make(map[string]bool)
+			if depends(n) {
+				affected = appendUnique(affected, r)
+				continue
+			}
+			// none of the macros reached by a failed search depends on name
+			for m := range visited {
+				memo[m] = false
+			}
+		}
+	}
+	sort.Strings(affected)
+	return affected
+}
+
+func (g *DepGraph) macroDepends(macro string, depends func(n *DepNode) bool, memo map[string]bool, visited map[string]bool) bool {
+	if v, ok := memo[macro]; ok {
+		return v
+	}
+	if visited[macro] {
+		return false
+	}
+	visited[macro] = true
+	n, ok := g.Macros[macro]
+	if ok && depends(n) {
+		memo[macro] = true
+		return true
+	}
+	return false
+}
+
+func (g *DepGraph) listDepends(list string, name string, seen map[string]bool) bool {
+	if seen[list] {
+		return false
+	}
+	seen[list] = true
+	if n, ok := g.Lists[list]; ok {
+		for _, l := range n.Lists {
+			if l == name || g.listDepends(l, name, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteJSON writes the dependency graph in JSON format.
+func (g *DepGraph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// WriteDOT writes the dependency graph in Graphviz DOT format.
+func (g *DepGraph) WriteDOT(w io.Writer) error {
+	fmt.Fprintln(w, "digraph policy {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	var fields []string
+	sections := []struct {
+		kind  string
+		shape string
+		nodes map[string]*DepNode
+	}{
+		{"rule", "box", g.Rules},
+		{"filter", "box", g.Filters},
+		{"macro", "ellipse", g.Macros},
+		{"list", "folder", g.Lists},
+	}
+	for _, s := range sections {
+		for _, name := range sortedNodes(s.nodes) {
+			n := s.nodes[name]
+			id := s.kind + ":" + name
+			fmt.Fprintf(w, "  %q [label=%q, shape=%s];\n", id, name, s.shape)
+			for _, m := range n.Macros {
+				fmt.Fprintf(w, "  %q -> %q;\n", id, "macro:"+m)
+			}
+			for _, l := range n.Lists {
+				fmt.Fprintf(w, "  %q -> %q;\n", id, "list:"+l)
+			}
+			for _, f := range n.Fields {
+				fmt.Fprintf(w, "  %q -> %q;\n", id, "field:"+f)
+				fields = appendUnique(fields, f)
+			}
+		}
+	}
+	sort.Strings(fields)
+	for _, f := range fields {
+		fmt.Fprintf(w, "  %q [label=%q, shape=plaintext];\n", "field:"+f, f)
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+func sortedNodes(m map[string]*DepNode) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendUnique(s []string, v string) []string {
+	if contains(s, v) {
+		return s
+	}
+	return append(s, v)
+}
@@ -0,0 +1,156 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
+)
+
+// Operand holds the value of a record attribute compared by a predicate.
+type Operand struct {
+	Attr  string
+	Value string
+}
+
+// Explanation describes a predicate that evaluated true when a rule matched a record.
+type Explanation struct {
+	Macro    string
+	Expr     string
+	Operands []Operand
+}
+
+// RuleExplanation holds the predicates that evaluated true when a rule matched a record.
+type RuleExplanation struct {
+	Rule         string
+	Explanations []Explanation
+}
+
+// explainTrace collects predicate explanations while rules are evaluated against a record.
+type explainTrace struct {
+	rules  []RuleExplanation
+	leaves []Explanation
+}
+
+// reset discards the explanations collected for the rule being evaluated.
+func (t *explainTrace) reset() {
+	t.leaves = t.leaves[:0]
+}
+
+// commit stores the explanations collected for a matching rule.
+func (t *explainTrace) commit(rule string) {
+	leaves := make([]Explanation, len(t.leaves))
+	copy(leaves, t.leaves)
+	t.rules = append(t.rules, RuleExplanation{Rule: rule, Explanations: leaves})
+}
+
+// scope discards the explanations collected while evaluating c if c evaluates to false.
+func (pi *PolicyInterpreter) scope(c Criterion) Criterion {
+	if !pi.explain {
+		return c
+	}
+	p := func(r *Record) bool {
+		t := r.Ctx.trace()
+		mark := len(t.leaves)
+		if !c.Eval(r) {
+			t.leaves = t.leaves[:mark]
+			return false
+		}
+		return true
+	}
+	return Criterion{p}
+}
+
+// explainTerm wraps the criterion c compiled from term ctx to record explanations.
+func (pi *PolicyInterpreter) explainTerm(ctx *parser.TermContext, c Criterion) Criterion {
+	if name, ok := macroRef(ctx); ok {
+		if _, ok := pi.macroCtxs[name]; ok {
+			return explainMacro(name, c)
+		}
+		return c
+	}
+	switch {
+	case ctx.Expression() != nil:
+		return c
+	case ctx.NOT() != nil:
+		return explainLeaf(termText(ctx), nil, func(r *Record) bool {
+			t := r.Ctx.trace()
+			mark := len(t.leaves)
+			res := c.Eval(r)
+			t.leaves = t.leaves[:mark]
+			return res
+		})
+	}
+	var operands []string
+	for _, a := range ctx.AllAtom() {
+		if attr := pi.atom(a); Mapper.IsField(attr) {
+			operands = append(operands, attr)
+		}
+	}
+	return explainLeaf(termText(ctx), operands, c.Pred)
+}
+
+// explainMacro attributes the explanations collected while evaluating c to macro name.
+func explainMacro(name string, c Criterion) Criterion {
+	p := func(r *Record) bool {
+		t := r.Ctx.trace()
+		mark := len(t.leaves)
+		if !c.Eval(r) {
+			t.leaves = t.leaves[:mark]
+			return false
+		}
+		for i := mark; i < len(t.leaves); i++ {
+			if t.leaves[i].Macro == "" {
+				t.leaves[i].Macro = name
+			}
+		}
+		return true
+	}
+	return Criterion{p}
+}
+
+// explainLeaf records an explanation for predicate expr, with the values of attrs, when pred evaluates to true.
+func explainLeaf(expr string, attrs []string, pred Predicate) Criterion {
+	ms := make([]StrFieldMap, len(attrs))
+	for i, attr := range attrs {
+		ms[i] = Mapper.MapStr(attr)
+	}
+	p := func(r *Record) bool {
+		if !pred(r) {
+			return false
+		}
+		e := Explanation{Expr: expr}
+		for i, m := range ms {
+			e.Operands = append(e.Operands, Operand{Attr: attrs[i], Value: m(r)})
+		}
+		t := r.Ctx.trace()
+		t.leaves = append(t.leaves, e)
+		return true
+	}
+	return Criterion{p}
+}
+
+// termText returns the source text of a term, including whitespace.
+func termText(ctx antlr.ParserRuleContext) string {
+	interval := antlr.Interval{Start: ctx.GetStart().GetStart(), Stop: ctx.GetStop().GetStop()}
+	return ctx.GetStart().GetInputStream().GetTextFromInterval(&interval)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/cespare/xxhash/v2"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
 	"github.com/tidwall/gjson"
 )
 
@@ -68,6 +69,15 @@ const (
 	SectMeta   SectionType = 8
 	SectPod    SectionType = 9
 	SectK8sEvt SectionType = 10
+	SectPFlow  SectionType = 15
+	SectDedup  SectionType = 16
+	SectAgg    SectionType = 17
+	SectEntity SectionType = 18
+
+	SectExtProc     SectionType = 11
+	SectExtFile     SectionType = 12
+	SectExtNet      SectionType = 13
+	SectExtTargProc SectionType = 14
 )
 
 // Attribute ID constants
@@ -111,13 +121,30 @@ func (m FieldMapper) Map(attr string) FieldMap {
 	if mapper, ok := m.Mappers[attr]; ok {
 		return mapper.Map
 	}
+	if fm, ok := mapAncestry(attr); ok {
+		return fm
+	}
 	return func(r *Record) interface{} { return attr }
 }
 
+// IsField checks whether attr denotes a record attribute rather than a literal.
+func (m FieldMapper) IsField(attr string) bool {
+	baseattr, _, isPathExp := cut(attr, "[")
+	if !isPathExp {
+		baseattr = attr
+	}
+	if _, ok := m.Mappers[baseattr]; ok {
+		return true
+	}
+	_, ok := mapAncestry(attr)
+	return ok
+}
+
 // MapInt retrieves a numerical field map based on a SysFlow attribute.
 func (m FieldMapper) MapInt(attr string) IntFieldMap {
+	fm := m.Map(attr)
 	return func(r *Record) int64 {
-		if v, ok := m.Map(attr)(r).(int64); ok {
+		if v, ok := fm(r).(int64); ok {
 			return v
 		}
 		if v, err := strconv.ParseInt(attr, 10, 64); err == nil {
@@ -158,18 +185,21 @@ func cut(s string, sep string) (before string, after string, found bool) {
 
 // MapStr retrieves a string field map based on a SysFlow attribute.
 func (m FieldMapper) MapStr(attr string) StrFieldMap {
-	return func(r *Record) string {
-		baseattr, jsonpath, isPathExp := cut(attr, "[")
-		if isPathExp { // check if baseattr is field name
-			_, isPathExp = m.Mappers[baseattr]
-		} else {
-			baseattr = attr
-		}
-		if isPathExp { // trim ']'
-			jsonpath = jsonpath[:len(jsonpath)-1]
+	baseattr, jsonpath, isPathExp := cut(attr, "[")
+	if isPathExp { // check if baseattr is field name
+		_, isPathExp = m.Mappers[baseattr]
+		if _, ok := mapAncestry(attr); ok { // ancestry expression
+			baseattr, isPathExp = attr, false
 		}
-
-		o := m.Map(baseattr)(r)
+	} else {
+		baseattr = attr
+	}
+	if isPathExp { // trim ']'
+		jsonpath = jsonpath[:len(jsonpath)-1]
+	}
+	fm := m.Map(baseattr)
+	return func(r *Record) string {
+		o := fm(r)
 		if v, ok := o.(string); ok {
 			if isPathExp && v != "" && jsonpath != "" {
 				return gjson.Get(v, jsonpath).String()
@@ -222,11 +252,13 @@ type FieldValue struct {
 }
 
 // FieldValues is the set of exported attributes
-var FieldValues = getFieldsAndValues()
+var FieldValues = getFieldsAndValues(getExportedMappers())
 
-// getFields returns a sorted array of all exported field mapper keys.
-func getFieldsAndValues() []*FieldValue {
-	mappers := getExportedMappers()
+// ExtFieldValues is the set of extended attributes
+var ExtFieldValues = getFieldsAndValues(getExtendedMappers())
+
+// getFieldsAndValues returns a sorted array of field values for a set of field mappers.
+func getFieldsAndValues(mappers map[string]*FieldEntry) []*FieldValue {
 	fields := make([]*FieldValue, 0, len(mappers))
 	for k, v := range mappers {
 		field := &FieldValue{FieldName: k,
@@ -248,11 +280,13 @@ func getFieldsAndValues() []*FieldValue {
 
 func getMappers() map[string]*FieldEntry {
 	mappers := getExportedMappers()
-	for k, v := range getNonExportedMappers() {
-		if _, ok := mappers[k]; !ok {
-			mappers[k] = v
-		} else if ok {
-			logger.Warn.Println("Duplicate mapper key: ", k)
+	for _, m := range []map[string]*FieldEntry{getExtendedMappers(), getNonExportedMappers()} {
+		for k, v := range m {
+			if _, ok := mappers[k]; !ok {
+				mappers[k] = v
+			} else if ok {
+				logger.Warn.Println("Duplicate mapper key: ", k)
+			}
 		}
 	}
 	return mappers
@@ -291,6 +325,7 @@ func getExportedMappers() map[string]*FieldEntry {
 		SF_PROC_AEXE:     &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAExe), FlatIndex: A_IDS, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC, Section: SectProc, AuxAttr: ProcAExe},
 		SF_PROC_ACMDLINE: &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcACmdLine), FlatIndex: A_IDS, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC, Section: SectProc, AuxAttr: ProcACmdLine},
 		SF_PROC_APID:     &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcAPID), FlatIndex: A_IDS, Type: MapArrayInt, Source: sfgo.SYSFLOW_SRC, Section: SectProc, AuxAttr: ProcAPID},
+		SF_PROC_ADEPTH:   &FieldEntry{Map: mapCachedValue(sfgo.SYSFLOW_SRC, ProcADepth), FlatIndex: A_IDS, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC, Section: SectProc, AuxAttr: ProcADepth},
 
 		SF_PPROC_OID:      &FieldEntry{Map: mapOID(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_HPID_INT, sfgo.PROC_POID_CREATETS_INT), FlatIndex: sfgo.PROC_POID_HPID_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectPProc},
 		SF_PPROC_PID:      &FieldEntry{Map: mapInt(sfgo.SYSFLOW_SRC, sfgo.PROC_POID_HPID_INT), FlatIndex: sfgo.PROC_POID_HPID_INT, Type: MapIntVal, Source: sfgo.SYSFLOW_SRC, Section: SectPProc},
@@ -330,10 +365,10 @@ func getExportedMappers() map[string]*FieldEntry {
 		SF_NET_DIP:   &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_DIP_INT), FlatIndex: sfgo.FL_NETW_DIP_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectNet},
 		SF_NET_IP:    &FieldEntry{Map: mapIP(sfgo.SYSFLOW_SRC, sfgo.FL_NETW_SIP_INT, sfgo.FL_NETW_DIP_INT), FlatIndex: sfgo.FL_NETW_SIP_INT, Type: MapArrayStr, Source: sfgo.SYSFLOW_SRC, Section: SectNet},
 
-		SF_FLOW_RBYTES: &FieldEntry{Map: mapSum(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMRRECVBYTES_INT, sfgo.FL_NETW_NUMRRECVBYTES_INT), FlatIndex: sfgo.FL_FILE_NUMRRECVBYTES_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
-		SF_FLOW_ROPS:   &FieldEntry{Map: mapSum(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMRRECVOPS_INT, sfgo.FL_NETW_NUMRRECVOPS_INT), FlatIndex: sfgo.FL_FILE_NUMRRECVOPS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
-		SF_FLOW_WBYTES: &FieldEntry{Map: mapSum(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMWSENDBYTES_INT, sfgo.FL_NETW_NUMWSENDBYTES_INT), FlatIndex: sfgo.FL_FILE_NUMWSENDBYTES_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
-		SF_FLOW_WOPS:   &FieldEntry{Map: mapSum(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMWSENDOPS_INT, sfgo.FL_NETW_NUMWSENDOPS_INT), FlatIndex: sfgo.FL_FILE_NUMWSENDOPS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
+		SF_FLOW_RBYTES: &FieldEntry{Map: mapFlowInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMRRECVBYTES_INT), FlatIndex: sfgo.FL_FILE_NUMRRECVBYTES_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
+		SF_FLOW_ROPS:   &FieldEntry{Map: mapFlowInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMRRECVOPS_INT), FlatIndex: sfgo.FL_FILE_NUMRRECVOPS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
+		SF_FLOW_WBYTES: &FieldEntry{Map: mapFlowInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMWSENDBYTES_INT), FlatIndex: sfgo.FL_FILE_NUMWSENDBYTES_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
+		SF_FLOW_WOPS:   &FieldEntry{Map: mapFlowInt(sfgo.SYSFLOW_SRC, sfgo.FL_FILE_NUMWSENDOPS_INT), FlatIndex: sfgo.FL_FILE_NUMWSENDOPS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectFlow},
 
 		SF_CONTAINER_ID:         &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_ID_STR), FlatIndex: sfgo.CONT_ID_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectCont},
 		SF_CONTAINER_NAME:       &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.CONT_NAME_STR), FlatIndex: sfgo.CONT_NAME_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectCont},
@@ -359,6 +394,18 @@ func getExportedMappers() map[string]*FieldEntry {
 		SF_K8SE_KIND:    &FieldEntry{Map: mapKind(sfgo.SYSFLOW_SRC, sfgo.K8SE_KIND_INT), FlatIndex: sfgo.K8SE_KIND_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectK8sEvt},
 		SF_K8SE_MESSAGE: &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.K8SE_MESSAGE_STR), FlatIndex: sfgo.K8SE_MESSAGE_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectK8sEvt},
 
+		SF_PF_THREADSCLONED: &FieldEntry{Map: mapProcFlowInt(sfgo.SYSFLOW_SRC, layout.FL_PROC_NUMTHREADSCLONED_INT), FlatIndex: layout.FL_PROC_NUMTHREADSCLONED_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectPFlow},
+		SF_PF_THREADSEXITED: &FieldEntry{Map: mapProcFlowInt(sfgo.SYSFLOW_SRC, layout.FL_PROC_NUMTHREADSEXITED_INT), FlatIndex: layout.FL_PROC_NUMTHREADSEXITED_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectPFlow},
+		SF_PF_CLONEERRORS:   &FieldEntry{Map: mapProcFlowInt(sfgo.SYSFLOW_SRC, layout.FL_PROC_NUMCLONEERRORS_INT), FlatIndex: layout.FL_PROC_NUMCLONEERRORS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectPFlow},
+
+		SF_DEDUP_COUNT:   &FieldEntry{Map: mapSummary(sfgo.SYSFLOW_SRC, layout.DEDUP_COUNT_INT), FlatIndex: layout.DEDUP_COUNT_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectDedup},
+		SF_DEDUP_FIRSTTS: &FieldEntry{Map: mapSummary(sfgo.SYSFLOW_SRC, layout.DEDUP_FIRSTTS_INT), FlatIndex: layout.DEDUP_FIRSTTS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectDedup},
+		SF_DEDUP_LASTTS:  &FieldEntry{Map: mapSummary(sfgo.SYSFLOW_SRC, layout.DEDUP_LASTTS_INT), FlatIndex: layout.DEDUP_LASTTS_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectDedup},
+
+		SF_AGG_COUNT: &FieldEntry{Map: mapSummary(sfgo.SYSFLOW_SRC, layout.AGG_COUNT_INT), FlatIndex: layout.AGG_COUNT_INT, Type: MapSpecialInt, Source: sfgo.SYSFLOW_SRC, Section: SectAgg},
+
+		SF_ENTITY_STATE: &FieldEntry{Map: mapEntityState(sfgo.SYSFLOW_SRC), FlatIndex: sfgo.PROC_STATE_INT, Type: MapSpecialStr, Source: sfgo.SYSFLOW_SRC, Section: SectEntity},
+
 		SF_NODE_ID: &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.SFHE_EXPORTER_STR), FlatIndex: sfgo.SFHE_EXPORTER_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectNode},
 		SF_NODE_IP: &FieldEntry{Map: mapStr(sfgo.SYSFLOW_SRC, sfgo.SFHE_IP_STR), FlatIndex: sfgo.SFHE_IP_STR, Type: MapStrVal, Source: sfgo.SYSFLOW_SRC, Section: SectNode},
 
@@ -368,77 +415,77 @@ func getExportedMappers() map[string]*FieldEntry {
 }
 
 // getExtendedMappers defines all mappers for extended attributes.
-// nolint
-func getExtendedMappers() map[string]FieldMap {
-	return map[string]FieldMap{
+// Extended attributes are read from the PROCESS, FILE, NETWORK and TARG_PROC sources of multi-source flat records.
+func getExtendedMappers() map[string]*FieldEntry {
+	return map[string]*FieldEntry{
 		//Ext processes
-		EXT_PROC_GUID_STR:                mapStr(sfgo.PROCESS_SRC, sfgo.PROC_GUID_STR),
-		EXT_PROC_IMAGE_STR:               mapStr(sfgo.PROCESS_SRC, sfgo.PROC_IMAGE_STR),
-		EXT_PROC_CURR_DIRECTORY_STR:      mapDir(sfgo.PROCESS_SRC, sfgo.PROC_CURR_DIRECTORY_STR),
-		EXT_PROC_LOGON_GUID_STR:          mapStr(sfgo.PROCESS_SRC, sfgo.PROC_LOGON_GUID_STR),
-		EXT_PROC_LOGON_ID_STR:            mapStr(sfgo.PROCESS_SRC, sfgo.PROC_LOGON_ID_STR),
-		EXT_PROC_TERMINAL_SESSION_ID_STR: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_TERMINAL_SESSION_ID_STR),
-		EXT_PROC_INTEGRITY_LEVEL_STR:     mapStr(sfgo.PROCESS_SRC, sfgo.PROC_INTEGRITY_LEVEL_STR),
-		EXT_PROC_SIGNATURE_STR:           mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SIGNATURE_STR),
-		EXT_PROC_SIGNATURE_STATUS_STR:    mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SIGNATURE_STATUS_STR),
-		EXT_PROC_SHA1_HASH_STR:           mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SHA1_HASH_STR),
-		EXT_PROC_MD5_HASH_STR:            mapStr(sfgo.PROCESS_SRC, sfgo.PROC_MD5_HASH_STR),
-		EXT_PROC_SHA256_HASH_STR:         mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SHA256_HASH_STR),
-		EXT_PROC_IMP_HASH_STR:            mapStr(sfgo.PROCESS_SRC, sfgo.PROC_IMP_HASH_STR),
-		EXT_PROC_SIGNED_INT:              mapInt(sfgo.PROCESS_SRC, sfgo.PROC_SIGNED_INT),
+		EXT_PROC_GUID_STR:                &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_GUID_STR), FlatIndex: sfgo.PROC_GUID_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_IMAGE_STR:               &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_IMAGE_STR), FlatIndex: sfgo.PROC_IMAGE_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_CURR_DIRECTORY_STR:      &FieldEntry{Map: mapDir(sfgo.PROCESS_SRC, sfgo.PROC_CURR_DIRECTORY_STR), FlatIndex: sfgo.PROC_CURR_DIRECTORY_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_LOGON_GUID_STR:          &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_LOGON_GUID_STR), FlatIndex: sfgo.PROC_LOGON_GUID_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_LOGON_ID_STR:            &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_LOGON_ID_STR), FlatIndex: sfgo.PROC_LOGON_ID_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_TERMINAL_SESSION_ID_STR: &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_TERMINAL_SESSION_ID_STR), FlatIndex: sfgo.PROC_TERMINAL_SESSION_ID_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_INTEGRITY_LEVEL_STR:     &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_INTEGRITY_LEVEL_STR), FlatIndex: sfgo.PROC_INTEGRITY_LEVEL_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_SIGNATURE_STR:           &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SIGNATURE_STR), FlatIndex: sfgo.PROC_SIGNATURE_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_SIGNATURE_STATUS_STR:    &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SIGNATURE_STATUS_STR), FlatIndex: sfgo.PROC_SIGNATURE_STATUS_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_SHA1_HASH_STR:           &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SHA1_HASH_STR), FlatIndex: sfgo.PROC_SHA1_HASH_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_MD5_HASH_STR:            &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_MD5_HASH_STR), FlatIndex: sfgo.PROC_MD5_HASH_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_SHA256_HASH_STR:         &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_SHA256_HASH_STR), FlatIndex: sfgo.PROC_SHA256_HASH_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_IMP_HASH_STR:            &FieldEntry{Map: mapStr(sfgo.PROCESS_SRC, sfgo.PROC_IMP_HASH_STR), FlatIndex: sfgo.PROC_IMP_HASH_STR, Type: MapStrVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
+		EXT_PROC_SIGNED_INT:              &FieldEntry{Map: mapInt(sfgo.PROCESS_SRC, sfgo.PROC_SIGNED_INT), FlatIndex: sfgo.PROC_SIGNED_INT, Type: MapBoolVal, Source: sfgo.PROCESS_SRC, Section: SectExtProc},
 
 		//Ext files
-		EXT_FILE_SIGNATURE_STR:        mapStr(sfgo.FILE_SRC, sfgo.FILE_SIGNATURE_STR),
-		EXT_FILE_SIGNATURE_STATUS_STR: mapStr(sfgo.FILE_SRC, sfgo.FILE_SIGNATURE_STATUS_STR),
-		EXT_FILE_SHA1_HASH_STR:        mapStr(sfgo.FILE_SRC, sfgo.FILE_SHA1_HASH_STR),
-		EXT_FILE_MD5_HASH_STR:         mapStr(sfgo.FILE_SRC, sfgo.FILE_MD5_HASH_STR),
-		EXT_FILE_SHA256_HASH_STR:      mapStr(sfgo.FILE_SRC, sfgo.FILE_SHA256_HASH_STR),
-		EXT_FILE_IMP_HASH_STR:         mapStr(sfgo.FILE_SRC, sfgo.FILE_IMP_HASH_STR),
-		EXT_FILE_SIGNED_INT:           mapInt(sfgo.FILE_SRC, sfgo.FILE_SIGNED_INT),
+		EXT_FILE_SIGNATURE_STR:        &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_SIGNATURE_STR), FlatIndex: sfgo.FILE_SIGNATURE_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_SIGNATURE_STATUS_STR: &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_SIGNATURE_STATUS_STR), FlatIndex: sfgo.FILE_SIGNATURE_STATUS_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_SHA1_HASH_STR:        &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_SHA1_HASH_STR), FlatIndex: sfgo.FILE_SHA1_HASH_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_MD5_HASH_STR:         &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_MD5_HASH_STR), FlatIndex: sfgo.FILE_MD5_HASH_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_SHA256_HASH_STR:      &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_SHA256_HASH_STR), FlatIndex: sfgo.FILE_SHA256_HASH_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_IMP_HASH_STR:         &FieldEntry{Map: mapStr(sfgo.FILE_SRC, sfgo.FILE_IMP_HASH_STR), FlatIndex: sfgo.FILE_IMP_HASH_STR, Type: MapStrVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
+		EXT_FILE_SIGNED_INT:           &FieldEntry{Map: mapInt(sfgo.FILE_SRC, sfgo.FILE_SIGNED_INT), FlatIndex: sfgo.FILE_SIGNED_INT, Type: MapBoolVal, Source: sfgo.FILE_SRC, Section: SectExtFile},
 
 		//Ext network
-		EXT_NET_SOURCE_HOST_NAME_STR: mapStr(sfgo.NETWORK_SRC, sfgo.NET_SOURCE_HOST_NAME_STR),
-		EXT_NET_SOURCE_PORT_NAME_STR: mapStr(sfgo.NETWORK_SRC, sfgo.NET_SOURCE_PORT_NAME_STR),
-		EXT_NET_DEST_HOST_NAME_STR:   mapStr(sfgo.NETWORK_SRC, sfgo.NET_DEST_HOST_NAME_STR),
-		EXT_NET_DEST_PORT_NAME_STR:   mapStr(sfgo.NETWORK_SRC, sfgo.NET_DEST_PORT_NAME_STR),
+		EXT_NET_SOURCE_HOST_NAME_STR: &FieldEntry{Map: mapStr(sfgo.NETWORK_SRC, sfgo.NET_SOURCE_HOST_NAME_STR), FlatIndex: sfgo.NET_SOURCE_HOST_NAME_STR, Type: MapStrVal, Source: sfgo.NETWORK_SRC, Section: SectExtNet},
+		EXT_NET_SOURCE_PORT_NAME_STR: &FieldEntry{Map: mapStr(sfgo.NETWORK_SRC, sfgo.NET_SOURCE_PORT_NAME_STR), FlatIndex: sfgo.NET_SOURCE_PORT_NAME_STR, Type: MapStrVal, Source: sfgo.NETWORK_SRC, Section: SectExtNet},
+		EXT_NET_DEST_HOST_NAME_STR:   &FieldEntry{Map: mapStr(sfgo.NETWORK_SRC, sfgo.NET_DEST_HOST_NAME_STR), FlatIndex: sfgo.NET_DEST_HOST_NAME_STR, Type: MapStrVal, Source: sfgo.NETWORK_SRC, Section: SectExtNet},
+		EXT_NET_DEST_PORT_NAME_STR:   &FieldEntry{Map: mapStr(sfgo.NETWORK_SRC, sfgo.NET_DEST_PORT_NAME_STR), FlatIndex: sfgo.NET_DEST_PORT_NAME_STR, Type: MapStrVal, Source: sfgo.NETWORK_SRC, Section: SectExtNet},
 
 		//Ext target proc
-		EXT_TARG_PROC_OID_CREATETS_INT:       mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_OID_CREATETS_INT),
-		EXT_TARG_PROC_OID_HPID_INT:           mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_OID_HPID_INT),
-		EXT_TARG_PROC_TS_INT:                 mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TS_INT),
-		EXT_TARG_PROC_POID_CREATETS_INT:      mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_POID_CREATETS_INT),
-		EXT_TARG_PROC_POID_HPID_INT:          mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_POID_HPID_INT),
-		EXT_TARG_PROC_EXE_STR:                mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_EXE_STR),
-		EXT_TARG_PROC_EXEARGS_STR:            mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_EXEARGS_STR),
-		EXT_TARG_PROC_UID_INT:                mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_UID_INT),
-		EXT_TARG_PROC_GID_INT:                mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GID_INT),
-		EXT_TARG_PROC_USERNAME_STR:           mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_USERNAME_STR),
-		EXT_TARG_PROC_GROUPNAME_STR:          mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GROUPNAME_STR),
-		EXT_TARG_PROC_TTY_INT:                mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TTY_INT),
-		EXT_TARG_PROC_CONTAINERID_STRING_STR: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CONTAINERID_STRING_STR),
-		EXT_TARG_PROC_ENTRY_INT:              mapEntry(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_ENTRY_INT),
-
-		EXT_TARG_PROC_GUID_STR:                mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GUID_STR),
-		EXT_TARG_PROC_IMAGE_STR:               mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_IMAGE_STR),
-		EXT_TARG_PROC_CURR_DIRECTORY_STR:      mapDir(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CURR_DIRECTORY_STR),
-		EXT_TARG_PROC_LOGON_GUID_STR:          mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_LOGON_GUID_STR),
-		EXT_TARG_PROC_LOGON_ID_STR:            mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_LOGON_ID_STR),
-		EXT_TARG_PROC_TERMINAL_SESSION_ID_STR: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TERMINAL_SESSION_ID_STR),
-		EXT_TARG_PROC_INTEGRITY_LEVEL_STR:     mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_INTEGRITY_LEVEL_STR),
-		EXT_TARG_PROC_SIGNATURE_STR:           mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNATURE_STR),
-		EXT_TARG_PROC_SIGNATURE_STATUS_STR:    mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNATURE_STATUS_STR),
-		EXT_TARG_PROC_SHA1_HASH_STR:           mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SHA1_HASH_STR),
-		EXT_TARG_PROC_MD5_HASH_STR:            mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_MD5_HASH_STR),
-		EXT_TARG_PROC_SHA256_HASH_STR:         mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SHA256_HASH_STR),
-		EXT_TARG_PROC_IMP_HASH_STR:            mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_IMP_HASH_STR),
-		EXT_TARG_PROC_SIGNED_INT:              mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNED_INT),
-		EXT_TARG_PROC_START_ADDR_STR:          mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_ADDR_STR),
-		EXT_TARG_PROC_START_MODULE_STR:        mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_MODULE_STR),
-		EXT_TARG_PROC_START_FUNCTION_STR:      mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_FUNCTION_STR),
-		EXT_TARG_PROC_GRANT_ACCESS_STR:        mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GRANT_ACCESS_STR),
-		EXT_TARG_PROC_CALL_TRACE_STR:          mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CALL_TRACE_STR),
-		EXT_TARG_PROC_ACCESS_TYPE_STR:         mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_ACCESS_TYPE_STR),
-		EXT_TARG_PROC_NEW_THREAD_ID_INT:       mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_NEW_THREAD_ID_INT),
+		EXT_TARG_PROC_OID_CREATETS_INT:       &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_OID_CREATETS_INT), FlatIndex: sfgo.EVT_TARG_PROC_OID_CREATETS_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_OID_HPID_INT:           &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_OID_HPID_INT), FlatIndex: sfgo.EVT_TARG_PROC_OID_HPID_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_TS_INT:                 &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TS_INT), FlatIndex: sfgo.EVT_TARG_PROC_TS_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_POID_CREATETS_INT:      &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_POID_CREATETS_INT), FlatIndex: sfgo.EVT_TARG_PROC_POID_CREATETS_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_POID_HPID_INT:          &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_POID_HPID_INT), FlatIndex: sfgo.EVT_TARG_PROC_POID_HPID_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_EXE_STR:                &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_EXE_STR), FlatIndex: sfgo.EVT_TARG_PROC_EXE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_EXEARGS_STR:            &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_EXEARGS_STR), FlatIndex: sfgo.EVT_TARG_PROC_EXEARGS_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_UID_INT:                &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_UID_INT), FlatIndex: sfgo.EVT_TARG_PROC_UID_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_GID_INT:                &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GID_INT), FlatIndex: sfgo.EVT_TARG_PROC_GID_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_USERNAME_STR:           &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_USERNAME_STR), FlatIndex: sfgo.EVT_TARG_PROC_USERNAME_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_GROUPNAME_STR:          &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GROUPNAME_STR), FlatIndex: sfgo.EVT_TARG_PROC_GROUPNAME_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_TTY_INT:                &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TTY_INT), FlatIndex: sfgo.EVT_TARG_PROC_TTY_INT, Type: MapBoolVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_CONTAINERID_STRING_STR: &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CONTAINERID_STRING_STR), FlatIndex: sfgo.EVT_TARG_PROC_CONTAINERID_STRING_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_ENTRY_INT:              &FieldEntry{Map: mapEntry(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_ENTRY_INT), FlatIndex: sfgo.EVT_TARG_PROC_ENTRY_INT, Type: MapSpecialBool, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+
+		EXT_TARG_PROC_GUID_STR:                &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GUID_STR), FlatIndex: sfgo.EVT_TARG_PROC_GUID_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_IMAGE_STR:               &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_IMAGE_STR), FlatIndex: sfgo.EVT_TARG_PROC_IMAGE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_CURR_DIRECTORY_STR:      &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CURR_DIRECTORY_STR), FlatIndex: sfgo.EVT_TARG_PROC_CURR_DIRECTORY_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_LOGON_GUID_STR:          &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_LOGON_GUID_STR), FlatIndex: sfgo.EVT_TARG_PROC_LOGON_GUID_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_LOGON_ID_STR:            &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_LOGON_ID_STR), FlatIndex: sfgo.EVT_TARG_PROC_LOGON_ID_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_TERMINAL_SESSION_ID_STR: &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_TERMINAL_SESSION_ID_STR), FlatIndex: sfgo.EVT_TARG_PROC_TERMINAL_SESSION_ID_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_INTEGRITY_LEVEL_STR:     &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_INTEGRITY_LEVEL_STR), FlatIndex: sfgo.EVT_TARG_PROC_INTEGRITY_LEVEL_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_SIGNATURE_STR:           &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNATURE_STR), FlatIndex: sfgo.EVT_TARG_PROC_SIGNATURE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_SIGNATURE_STATUS_STR:    &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNATURE_STATUS_STR), FlatIndex: sfgo.EVT_TARG_PROC_SIGNATURE_STATUS_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_SHA1_HASH_STR:           &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SHA1_HASH_STR), FlatIndex: sfgo.EVT_TARG_PROC_SHA1_HASH_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_MD5_HASH_STR:            &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_MD5_HASH_STR), FlatIndex: sfgo.EVT_TARG_PROC_MD5_HASH_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_SHA256_HASH_STR:         &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SHA256_HASH_STR), FlatIndex: sfgo.EVT_TARG_PROC_SHA256_HASH_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_IMP_HASH_STR:            &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_IMP_HASH_STR), FlatIndex: sfgo.EVT_TARG_PROC_IMP_HASH_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_SIGNED_INT:              &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_SIGNED_INT), FlatIndex: sfgo.EVT_TARG_PROC_SIGNED_INT, Type: MapBoolVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_START_ADDR_STR:          &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_ADDR_STR), FlatIndex: sfgo.EVT_TARG_PROC_START_ADDR_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_START_MODULE_STR:        &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_MODULE_STR), FlatIndex: sfgo.EVT_TARG_PROC_START_MODULE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_START_FUNCTION_STR:      &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_START_FUNCTION_STR), FlatIndex: sfgo.EVT_TARG_PROC_START_FUNCTION_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_GRANT_ACCESS_STR:        &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_GRANT_ACCESS_STR), FlatIndex: sfgo.EVT_TARG_PROC_GRANT_ACCESS_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_CALL_TRACE_STR:          &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_CALL_TRACE_STR), FlatIndex: sfgo.EVT_TARG_PROC_CALL_TRACE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_ACCESS_TYPE_STR:         &FieldEntry{Map: mapStr(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_ACCESS_TYPE_STR), FlatIndex: sfgo.EVT_TARG_PROC_ACCESS_TYPE_STR, Type: MapStrVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
+		EXT_TARG_PROC_NEW_THREAD_ID_INT:       &FieldEntry{Map: mapInt(sfgo.TARG_PROC_SRC, sfgo.EVT_TARG_PROC_NEW_THREAD_ID_INT), FlatIndex: sfgo.EVT_TARG_PROC_NEW_THREAD_ID_INT, Type: MapIntVal, Source: sfgo.TARG_PROC_SRC, Section: SectExtTargProc},
 	}
 }
 
@@ -521,13 +568,26 @@ func mapSvcArray(src sfgo.Source, attr sfgo.Attribute) FieldMap {
 	return func(r *Record) interface{} { return r.GetSvcArray(attr, src) }
 }
 
-func mapSum(src sfgo.Source, attrs ...sfgo.Attribute) FieldMap {
+// mapFlowInt maps a counter of file and network flows, which share the flat record indices of their
+// counters. The counter is zero for other records.
+func mapFlowInt(src sfgo.Source, attr sfgo.Attribute) FieldMap {
 	return func(r *Record) interface{} {
-		var sum int64 = 0
-		for _, attr := range attrs {
-			sum += r.GetInt(attr, src)
+		switch r.GetInt(sfgo.SF_REC_TYPE, src) {
+		case sfgo.FILE_FLOW, sfgo.NET_FLOW:
+			return r.GetInt(attr, src)
+		default:
+			return sfgo.Zeros.Int64
 		}
-		return sum
+	}
+}
+
+// mapProcFlowInt maps a counter of process flows, which is zero for other records.
+func mapProcFlowInt(src sfgo.Source, attr sfgo.Attribute) FieldMap {
+	return func(r *Record) interface{} {
+		if r.GetInt(sfgo.SF_REC_TYPE, src) != sfgo.PROC_FLOW {
+			return sfgo.Zeros.Int64
+		}
+		return getExtInt(r, attr, src)
 	}
 }
 
@@ -548,6 +608,20 @@ func mapRecType(src sfgo.Source) FieldMap {
 	}
 }
 
+// mapEntityState maps the state of process and file entity records, which is empty for other records.
+func mapEntityState(src sfgo.Source) FieldMap {
+	return func(r *Record) interface{} {
+		switch r.GetInt(sfgo.SF_REC_TYPE, src) {
+		case sfgo.PROC:
+			return sfgo.SFObjectState(r.GetInt(sfgo.PROC_STATE_INT, src)).String()
+		case sfgo.FILE:
+			return sfgo.SFObjectState(r.GetInt(sfgo.FILE_STATE_INT, src)).String()
+		default:
+			return sfgo.Zeros.String
+		}
+	}
+}
+
 func mapOpFlags(src sfgo.Source) FieldMap {
 	return func(r *Record) interface{} {
 		opflags := r.GetInt(sfgo.EV_PROC_OPFLAGS_INT, src)
@@ -572,6 +646,8 @@ func mapRet(src sfgo.Source) FieldMap {
 			fallthrough
 		case sfgo.FILE_EVT:
 			return r.GetInt(sfgo.RET_INT, src)
+		case sfgo.NET_EVT:
+			return getExtInt(r, layout.EV_NET_RET_INT, src)
 		default:
 			return sfgo.Zeros.Int64
 		}
@@ -585,6 +661,8 @@ func mapEndTs(src sfgo.Source) FieldMap {
 			return r.GetInt(sfgo.FL_FILE_ENDTS_INT, src)
 		case sfgo.NET_FLOW:
 			return r.GetInt(sfgo.FL_NETW_ENDTS_INT, src)
+		case sfgo.PROC_FLOW:
+			return r.GetInt(layout.FL_PROC_ENDTS_INT, src)
 		case sfgo.K8S_EVT:
 			return r.GetInt(sfgo.TS_INT, src)
 		default:
@@ -593,6 +671,22 @@ func mapEndTs(src sfgo.Source) FieldMap {
 	}
 }
 
+// mapSummary maps a summary attribute, which is zero for records other than summaries.
+func mapSummary(src sfgo.Source, attr sfgo.Attribute) FieldMap {
+	return func(r *Record) interface{} { return getExtInt(r, attr, src) }
+}
+
+// getExtInt returns the int attribute attr of source src stored beyond the flat record schema of sf-apis,
+// or zero if the int array of the record does not extend to attr.
+func getExtInt(r *Record, attr sfgo.Attribute, src sfgo.Source) int64 {
+	for idx, s := range r.Fr.Sources {
+		if s == src && int(attr) < len(r.Fr.Ints[idx]) {
+			return r.Fr.Ints[idx][attr]
+		}
+	}
+	return sfgo.Zeros.Int64
+}
+
 // nolint
 func mapEntry(src sfgo.Source, attr sfgo.Attribute) FieldMap {
 	return func(r *Record) interface{} {
@@ -709,6 +803,37 @@ func mapCachedValue(src sfgo.Source, attr RecAttribute) FieldMap {
 	}
 }
 
+// ancestryAttrs maps ancestry fields to their auxiliary record attributes.
+var ancestryAttrs = map[string]RecAttribute{
+	SF_PROC_ANAME:    ProcAName,
+	SF_PROC_AEXE:     ProcAExe,
+	SF_PROC_ACMDLINE: ProcACmdLine,
+	SF_PROC_APID:     ProcAPID,
+	FALCO_PROC_ANAME: ProcAName,
+	FALCO_PROC_APID:  ProcAPID,
+}
+
+// mapAncestry maps ancestry expressions of the form 'sf.proc.aname[<depth>]' and 'sf.proc.anearest[<pattern>]'.
+func mapAncestry(attr string) (FieldMap, bool) {
+	baseattr, arg, found := cut(attr, "[")
+	if !found || !strings.HasSuffix(arg, "]") {
+		return nil, false
+	}
+	arg = arg[:len(arg)-1]
+	if baseattr == SF_PROC_ANEAREST {
+		if _, err := filepath.Match(arg, EMPTY); err != nil {
+			return nil, false
+		}
+		return func(r *Record) interface{} { return r.GetNearestAncestor(arg) }, true
+	}
+	if a, ok := ancestryAttrs[baseattr]; ok {
+		if i, err := strconv.Atoi(arg); err == nil && i >= 0 {
+			return func(r *Record) interface{} { return r.GetAncestorValue(i, a) }, true
+		}
+	}
+	return nil, false
+}
+
 func mapOID(src sfgo.Source, attrs ...sfgo.Attribute) FieldMap {
 	return func(r *Record) interface{} {
 		h := xxhash.New()
@@ -23,24 +23,14 @@ package engine
 
 import (
 	"errors"
-	"regexp"
-	"strconv"
-	"strings"
 	"sync"
 
-	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
-	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/errorhandler"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
 )
 
-// Regular expression for parsing lists.
-var itemsre = regexp.MustCompile(`(^\[)(.*)(\]$?)`)
-
 // PolicyInterpreter defines a rules engine for SysFlow data streams.
 type PolicyInterpreter struct {
-	*parser.BaseSfplListener
-
 	// Mode of the policy interpreter
 	mode Mode
 
@@ -49,8 +39,15 @@ type PolicyInterpreter struct {
 	filters []Filter
 
 	// Accessory parsing maps
-	lists     map[string][]string
-	macroCtxs map[string]parser.IExpressionContext
+	lists      map[string][]string
+	macroCtxs  map[string]parser.IExpressionContext
+	macros     map[string]*macroDecl
+	ruleCtxs   map[string]parser.IExpressionContext
+	filterCtxs map[string]parser.IExpressionContext
+
+	// Compilation state for expanding macros
+	pf     *policyFile
+	scopes []*macroScope
 
 	// Worker channel and waitgroup
 	workerCh chan *Record
@@ -62,8 +59,14 @@ type PolicyInterpreter struct {
 	// Worker pool size
 	concurrency int
 
-	// Action Handler
+	// Action Handler (nil for shadow interpreters)
 	ah *ActionHandler
+
+	// Record rule match explanations
+	explain bool
+
+	// Policy coverage statistics
+	cov *Coverage
 }
 
 // NewPolicyInterpreter constructs a new interpreter instance.
@@ -75,8 +78,17 @@ func NewPolicyInterpreter(conf Config, out func(*Record)) *PolicyInterpreter {
 	pi.filters = make([]Filter, 0)
 	pi.lists = make(map[string][]string)
 	pi.macroCtxs = make(map[string]parser.IExpressionContext)
+	pi.macros = make(map[string]*macroDecl)
+	pi.ruleCtxs = make(map[string]parser.IExpressionContext)
+	pi.filterCtxs = make(map[string]parser.IExpressionContext)
 	pi.out = out
-	pi.ah = NewActionHandler(conf)
+	if !conf.Shadow {
+		pi.ah = NewActionHandler(conf)
+	}
+	pi.explain = conf.Explain
+	if conf.Coverage != "" {
+		pi.cov = newCoverage()
+	}
 	return pi
 }
 
@@ -93,61 +105,102 @@ func (pi *PolicyInterpreter) StartWorkers() {
 
 // StopWorkers stops the worker pool and waits for all tasks to finish.
 func (pi *PolicyInterpreter) StopWorkers() {
+	if pi.workerCh == nil {
+		return
+	}
 	logger.Trace.Println("Stopping policy engine's thread pool")
 	close(pi.workerCh)
 	pi.wg.Wait()
+	pi.workerCh = nil
 }
 
-// Compile parses and interprets an input policy defined in path.
+// compile parses and interprets an input policy defined in path.
 func (pi *PolicyInterpreter) compile(path string) error {
-	// Setup the input
-	is, err := antlr.NewFileStream(path)
+	// Parse the policy file
+	pf, err := loadPolicyFile(path)
 	if err != nil {
 		logger.Error.Println("Error reading policy from path", path)
 		return err
 	}
 
-	// Create the Lexer
-	lexerErrors := &errorhandler.SfplErrorListener{}
-	lexer := parser.NewSfplLexer(is)
-	lexer.RemoveErrorListeners()
-	lexer.AddErrorListener(lexerErrors)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
-
-	// Create the Parser
-	parserErrors := &errorhandler.SfplErrorListener{}
-	p := parser.NewSfplParser(stream)
-	p.RemoveErrorListeners()
-	p.AddErrorListener(parserErrors)
-
-	// Pre-processing (to deal with usage before definitions of macros and lists)
-	antlr.ParseTreeWalkerDefault.Walk(pi, p.Defs())
-	p.GetInputStream().Seek(0)
-
-	// Parse the policy
-	antlr.ParseTreeWalkerDefault.Walk(pi, p.Policy())
+	// Interpret the policy declarations
+	pi.load(pf)
 
-	errFound := false
-	if len(lexerErrors.Errors) > 0 {
-		logger.Error.Printf("Lexer %d errors found\n", len(lexerErrors.Errors))
-		for _, e := range lexerErrors.Errors {
+	if len(pf.errs) > 0 {
+		logger.Error.Printf("Policy %d errors found\n", len(pf.errs))
+		for _, e := range pf.errs {
 			logger.Error.Println("\t", e.Error())
 		}
-		errFound = true
+		return errors.New("errors found during compilation of policies. check logs for detail")
 	}
-	if len(parserErrors.Errors) > 0 {
-		logger.Error.Printf("Parser %d errors found\n", len(parserErrors.Errors))
-		for _, e := range parserErrors.Errors {
-			logger.Error.Println("\t", e.Error())
+
+	return nil
+}
+
+// load interprets the declarations of a parsed policy file.
+func (pi *PolicyInterpreter) load(pf *policyFile) {
+	pi.pf = pf
+	defer func() { pi.pf = nil }()
+
+	// Pre-processing (to deal with usage before definitions of macros and lists)
+	for _, d := range pf.decls {
+		switch d.kind {
+		case declList:
+			logger.Trace.Println("Parsing list ", d.Name())
+			pi.lists[d.Name()] = pf.list(d, keyItems)
+		case declMacro:
+			logger.Trace.Println("Parsing macro ", d.Name())
+			name, params := pf.signature(d)
+			if pi.cov != nil {
+				pi.cov.addMacro(name)
+			}
+			if ctx := pf.parseCondition(d); ctx != nil {
+				pf.checkParams(d, ctx, params)
+				pi.macroCtxs[name] = ctx
+				pi.macros[name] = &macroDecl{params: params, pf: pf, d: d}
+			}
 		}
-		errFound = true
 	}
 
-	if errFound {
-		return errors.New("errors found during compilation of policies. check logs for detail")
+	// Parse the policy
+	for _, d := range pf.decls {
+		switch d.kind {
+		case declFilter, declDrop:
+			logger.Trace.Println("Parsing filter ", d.Name())
+			if _, ok := pi.filterCtxs[d.Name()]; ok {
+				pf.errorf(d.name, d, "duplicate filter name")
+			} else if ctx := pf.parseCondition(d); ctx != nil {
+				pf.checkParams(d, ctx, nil)
+				f := Filter{
+					Name:      d.Name(),
+					condition: pi.compileScoped(&macroScope{pf: pf, d: d}, func() Criterion { return pi.visitExpression(ctx) }),
+					Enabled:   pf.enabled(d),
+				}
+				pi.filters = append(pi.filters, f)
+				pi.filterCtxs[d.Name()] = ctx
+			}
+		case declRule:
+			logger.Trace.Println("Parsing rule ", d.Name())
+			if _, ok := pi.ruleCtxs[d.Name()]; ok {
+				pf.errorf(d.name, d, "duplicate rule name")
+			} else if ctx := pf.parseCondition(d); ctx != nil {
+				pf.checkParams(d, ctx, nil)
+				desc, _ := pf.scalar(d, keyDesc)
+				r := Rule{
+					Name:      d.Name(),
+					Desc:      desc,
+					condition: pi.compileScoped(&macroScope{pf: pf, d: d}, func() Criterion { return pi.visitRule(d.Name(), ctx) }),
+					Actions:   pi.getActions(pf, d),
+					Tags:      pi.getTags(pf, d),
+					Priority:  pf.priority(d),
+					Prefilter: pf.list(d, keyPrefilter),
+					Enabled:   pf.enabled(d),
+				}
+				pi.rules = append(pi.rules, r)
+				pi.ruleCtxs[d.Name()] = ctx
+			}
+		}
 	}
-
-	return nil
 }
 
 // Compile parses and interprets a set of input policies defined in paths.
@@ -158,10 +211,17 @@ func (pi *PolicyInterpreter) Compile(paths ...string) error {
 			return err
 		}
 	}
-	pi.ah.CheckActions(pi.rules)
+	if pi.ah != nil {
+		pi.ah.CheckActions(pi.rules)
+	}
 	return nil
 }
 
+// Coverage returns the policy coverage statistics, or nil if coverage is not enabled.
+func (pi *PolicyInterpreter) Coverage() *Coverage {
+	return pi.cov
+}
+
 // ProcessAsync queues the record for processing in the worker pool.
 func (pi *PolicyInterpreter) ProcessAsync(r *Record) {
 	pi.workerCh <- r
@@ -177,25 +237,35 @@ func (pi *PolicyInterpreter) worker() {
 			break
 		}
 
+		// Summaries are not evaluated again: forward them unless in alert mode
+		if r.IsSummary() {
+			if pi.mode != AlertMode && pi.out != nil {
+				pi.out(r)
+			}
+			continue
+		}
+
 		// Drop record if any drop rule applied.
 		if pi.EvalFilters(r) {
 			continue
 		}
 
-		// Enrich mode is non-blocking: Push record even if no rule matches
-		match := (pi.mode == EnrichMode)
+		// Enrich and dual modes are non-blocking: Push record even if no rule matches
+		match := (pi.mode != AlertMode)
 
 		// Apply rules
 		for _, rule := range pi.rules {
-			if rule.Enabled && rule.isApplicable(r) && rule.condition.Eval(r) {
+			if pi.match(rule, r) {
 				r.Ctx.SetAlert(pi.mode == AlertMode)
 				r.Ctx.AddRule(rule)
-				pi.ah.HandleActions(rule, r)
+				if pi.ah != nil {
+					pi.ah.HandleActions(rule, r)
+				}
 				match = true
 			}
 		}
 
-		// Push record if a rule matches (or if mode is enrich)
+		// Push record if a rule matches (or if mode is enrich or dual)
 		if match && pi.out != nil {
 			pi.out(r)
 		}
@@ -205,30 +275,57 @@ func (pi *PolicyInterpreter) worker() {
 
 // Process executes all compiled policies against record r.
 func (pi *PolicyInterpreter) Process(r *Record) *Record {
+	// Summaries are not evaluated again: forward them unless in alert mode
+	if r.IsSummary() {
+		if pi.mode != AlertMode {
+			return r
+		}
+		return nil
+	}
+
 	// Drop record if any drop rule applies
 	if pi.EvalFilters(r) {
 		return nil
 	}
 
-	// Enrich mode is non-blocking: Push record even if no rule matches
-	match := (pi.mode == EnrichMode)
+	// Enrich and dual modes are non-blocking: Push record even if no rule matches
+	match := (pi.mode != AlertMode)
 
 	for _, rule := range pi.rules {
-		if rule.Enabled && rule.isApplicable(r) && rule.condition.Eval(r) {
+		if pi.match(rule, r) {
 			r.Ctx.SetAlert(pi.mode == AlertMode)
 			r.Ctx.AddRule(rule)
-			pi.ah.HandleActions(rule, r)
+			if pi.ah != nil {
+				pi.ah.HandleActions(rule, r)
+			}
 			match = true
 		}
 	}
 
-	// Push record if a rule matched (or if we are in enrich mode)
+	// Push record if a rule matched (or if we are in enrich or dual mode)
 	if match {
 		return r
 	}
 	return nil
 }
 
+// match evaluates rule against record r, recording an explanation of the match in explain mode.
+func (pi *PolicyInterpreter) match(rule Rule, r *Record) bool {
+	if !rule.Enabled || !rule.isApplicable(r) {
+		return false
+	}
+	if !pi.explain {
+		return rule.condition.Eval(r)
+	}
+	t := r.Ctx.trace()
+	t.reset()
+	if !rule.condition.Eval(r) {
+		return false
+	}
+	t.commit(rule.Name)
+	return true
+}
+
 // EvalFilters executes compiled policy filters against record r.
 func (pi *PolicyInterpreter) EvalFilters(r *Record) bool {
 	for _, f := range pi.filters {
@@ -239,155 +336,26 @@ func (pi *PolicyInterpreter) EvalFilters(r *Record) bool {
 	return false
 }
 
-// ExitList is called when production list is exited.
-func (pi *PolicyInterpreter) ExitPlist(ctx *parser.PlistContext) {
-	logger.Trace.Println("Parsing list ", ctx.GetText())
-	pi.lists[ctx.ID().GetText()] = pi.extractListFromItems(ctx.Items())
-}
-
-// ExitMacro is called when production macro is exited.
-func (pi *PolicyInterpreter) ExitPmacro(ctx *parser.PmacroContext) {
-	logger.Trace.Println("Parsing macro ", ctx.GetText())
-	pi.macroCtxs[ctx.ID().GetText()] = ctx.Expression()
-}
-
-// ExitFilter is called when production filter is exited.
-func (pi *PolicyInterpreter) ExitPfilter(ctx *parser.PfilterContext) {
-	logger.Trace.Println("Parsing filter ", ctx.GetText())
-	f := Filter{
-		Name:      ctx.ID().GetText(),
-		condition: pi.visitExpression(ctx.Expression()),
-		Enabled:   ctx.ENABLED() == nil || pi.getEnabledFlag(ctx.Enabled()),
-	}
-	pi.filters = append(pi.filters, f)
-}
-
-// ExitFilter is called when production filter is exited.
-func (pi *PolicyInterpreter) ExitPrule(ctx *parser.PruleContext) {
-	logger.Trace.Println("Parsing rule ", ctx.GetText())
-	r := Rule{
-		Name:      pi.getOffChannelText(ctx.Text(0)),
-		Desc:      pi.getOffChannelText(ctx.Text(1)),
-		condition: pi.visitExpression(ctx.Expression()),
-		Actions:   pi.getActions(ctx),
-		Tags:      pi.getTags(ctx),
-		Priority:  pi.getPriority(ctx),
-		Prefilter: pi.getPrefilter(ctx),
-		Enabled:   ctx.ENABLED(0) == nil || pi.getEnabledFlag(ctx.Enabled(0)),
-	}
-	pi.rules = append(pi.rules, r)
-}
-
-func (pi *PolicyInterpreter) getEnabledFlag(ctx parser.IEnabledContext) bool {
-	flag := trimBoundingQuotes(ctx.GetText())
-	if b, err := strconv.ParseBool(flag); err == nil {
-		return b
-	}
-	logger.Warn.Println("Unrecognized enabled flag: ", flag)
-	return true
-}
-
-func (pi *PolicyInterpreter) getOffChannelText(ctx parser.ITextContext) string {
-	a := ctx.GetStart().GetStart()
-	b := ctx.GetStop().GetStop()
-	interval := antlr.Interval{Start: a, Stop: b}
-	return ctx.GetStart().GetInputStream().GetTextFromInterval(&interval)
-}
-
-func (pi *PolicyInterpreter) getTags(ctx *parser.PruleContext) []EnrichmentTag {
+func (pi *PolicyInterpreter) getTags(pf *policyFile, d *policyDecl) []EnrichmentTag {
 	var tags = make([]EnrichmentTag, 0)
-	ictx := ctx.Tags(0)
-	if ictx != nil {
-		return append(tags, pi.extractTags(ictx))
+	if _, ok := d.attrs[keyTags]; ok {
+		return append(tags, pf.list(d, keyTags))
 	}
 	return tags
 }
 
-func (pi *PolicyInterpreter) getPrefilter(ctx *parser.PruleContext) []string {
-	var pfs = make([]string, 0)
-	ictx := ctx.Prefilter(0)
-	if ictx != nil {
-		return append(pfs, pi.extractList(ictx.GetText())...)
-	}
-	return pfs
-}
-
-func (pi *PolicyInterpreter) getPriority(ctx *parser.PruleContext) Priority {
-	ictx := ctx.Severity(0)
-	if ictx != nil {
-		p := ictx.GetText()
-		switch strings.ToLower(p) {
-		case Low.String():
-			return Low
-		case Medium.String():
-			return Medium
-		case High.String():
-			return High
-		case FPriorityDebug:
-			return Low
-		case FPriorityInfo:
-			return Low
-		case FPriorityInformational:
-			return Low
-		case FPriorityNotice:
-			return Low
-		case FPriorityWarning:
-			return Medium
-		case FPriorityError:
-			return High
-		case FPriorityCritical:
-			return High
-		case FPriorityEmergency:
-			return High
-		default:
-			logger.Warn.Printf("Unrecognized priority value %s. Deferring to %s\n", p, Low.String())
-		}
-	}
-	return Low
-}
-
-func (pi *PolicyInterpreter) getActions(ctx *parser.PruleContext) []string {
+func (pi *PolicyInterpreter) getActions(pf *policyFile, d *policyDecl) []string {
 	var actions []string
-	ictx := ctx.Actions(0)
-	if ictx != nil {
-		return append(actions, pi.extractActions(ictx)...)
+	if _, ok := d.attrs[keyActions]; ok {
+		return append(actions, pf.list(d, keyActions)...)
 	}
 	return actions
 }
 
-func (pi *PolicyInterpreter) extractList(str string) []string {
-	var items []string
-	for _, i := range strings.Split(itemsre.ReplaceAllString(str, "$2"), LISTSEP) {
-		items = append(items, trimBoundingQuotes(i))
-	}
-	return items
-}
-
-func (pi *PolicyInterpreter) extractListFromItems(ctx parser.IItemsContext) []string {
-	if ctx != nil {
-		return pi.extractList(ctx.GetText())
-	}
-	return []string{}
-}
-
-func (pi *PolicyInterpreter) extractTags(ctx parser.ITagsContext) []string {
-	if ctx != nil {
-		return pi.extractList(ctx.GetText())
-	}
-	return []string{}
-}
-
-func (pi *PolicyInterpreter) extractActions(ctx parser.IActionsContext) []string {
-	if ctx != nil {
-		return pi.extractList(ctx.GetText())
-	}
-	return []string{}
-}
-
 func (pi *PolicyInterpreter) extractListFromAtoms(ctxs []parser.IAtomContext) []string {
 	s := []string{}
 	for _, v := range ctxs {
-		s = append(s, pi.reduceList(v.GetText())...)
+		s = append(s, pi.reduceList(pi.atom(v))...)
 	}
 	return s
 }
@@ -417,30 +385,48 @@ func (pi *PolicyInterpreter) visitExpression(ctx parser.IExpressionContext) Crit
 					andPreds = append(andPreds, c)
 				}
 			}
-			orPreds = append(orPreds, All(andPreds))
+			orPreds = append(orPreds, pi.scope(All(andPreds)))
 		}
 	}
 	return Any(orPreds)
 }
 
 func (pi *PolicyInterpreter) visitTerm(ctx parser.ITermContext) Criterion {
+	termCtx := ctx.(*parser.TermContext)
+	if name, ok := macroRef(termCtx); ok && pi.cov != nil {
+		pi.cov.enterMacro(name)
+		defer pi.cov.exitMacro()
+	}
+	c := pi.compileTerm(ctx)
+	if pi.explain {
+		c = pi.explainTerm(termCtx, c)
+	}
+	if pi.cov != nil {
+		c = pi.instrumentTerm(termCtx, c)
+	}
+	return c
+}
+
+func (pi *PolicyInterpreter) compileTerm(ctx parser.ITermContext) Criterion {
 	termCtx := ctx.(*parser.TermContext)
 	if termCtx.Variable() != nil {
-		if m, ok := pi.macroCtxs[termCtx.GetText()]; ok {
-			return pi.visitExpression(m)
+		if _, ok := pi.macroCtxs[termCtx.GetText()]; ok {
+			return pi.expandMacro(termCtx, termCtx.GetText(), nil)
 		}
 		logger.Error.Println("Unrecognized reference ", termCtx.GetText())
+	} else if name, args, ok := macroCall(termCtx); ok {
+		return pi.expandMacro(termCtx, name, args)
 	} else if termCtx.NOT() != nil {
 		return pi.visitTerm(termCtx.GetChild(1).(parser.ITermContext)).Not()
 	} else if opCtx, ok := termCtx.Unary_operator().(*parser.Unary_operatorContext); ok {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
+		lop := pi.atom(termCtx.Atom(0))
 		if opCtx.EXISTS() != nil {
 			return Exists(lop)
 		}
 		logger.Error.Println("Unrecognized unary operator ", opCtx.GetText())
 	} else if opCtx, ok := termCtx.Binary_operator().(*parser.Binary_operatorContext); ok {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
-		rop := termCtx.Atom(1).(*parser.AtomContext).GetText()
+		lop := pi.atom(termCtx.Atom(0))
+		rop := pi.atom(termCtx.Atom(1))
 		if opCtx.CONTAINS() != nil {
 			return Contains(lop, rop)
 		} else if opCtx.ICONTAINS() != nil {
@@ -466,11 +452,11 @@ func (pi *PolicyInterpreter) visitTerm(ctx parser.ITermContext) Criterion {
 	} else if termCtx.Expression() != nil {
 		return pi.visitExpression(termCtx.Expression())
 	} else if termCtx.IN() != nil {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
+		lop := pi.atom(termCtx.Atom(0))
 		rop := termCtx.AllAtom()[1:]
 		return In(lop, pi.extractListFromAtoms(rop))
 	} else if termCtx.PMATCH() != nil {
-		lop := termCtx.Atom(0).(*parser.AtomContext).GetText()
+		lop := pi.atom(termCtx.Atom(0))
 		rop := termCtx.AllAtom()[1:]
 		return PMatch(lop, pi.extractListFromAtoms(rop))
 	} else {
@@ -21,11 +21,15 @@ package engine
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
 )
 
 var pi *PolicyInterpreter
@@ -48,3 +52,409 @@ func TestCompileDist(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, pi.Compile(paths...))
 }
+
+func compilePolicy(t *testing.T, conf Config, policy string) (*PolicyInterpreter, []error) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(policy), 0600))
+	pf, err := loadPolicyFile(path)
+	assert.NoError(t, err)
+	pi := NewPolicyInterpreter(conf, nil)
+	pi.load(pf)
+	return pi, pf.errs
+}
+
+func TestCompileYAML(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{}, `
+- list: shells
+  items: &shells [bash, "sh", '"zsh"']
+
+- macro: spawned_shell
+  condition: >
+    sf.opflags = EXEC and
+    sf.proc.name in (shells)
+
+- rule: "Shell: spawned"
+  desc: |
+    a shell was spawned
+  condition: |
+    spawned_shell and
+      sf.proc.exe pmatch (shells)
+  priority: warning
+  tags: *shells
+
+- filter: no_tty
+  condition: sf.proc.tty = true
+  enabled: false
+`)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"bash", "sh", "zsh"}, pi.lists["shells"])
+	assert.Len(t, pi.rules, 1)
+	assert.Equal(t, "Shell: spawned", pi.rules[0].Name)
+	assert.Equal(t, "a shell was spawned\n", pi.rules[0].Desc)
+	assert.Equal(t, Warning, pi.rules[0].Priority)
+	assert.Equal(t, []EnrichmentTag{[]string{"bash", "sh", "zsh"}}, pi.rules[0].Tags)
+	assert.Len(t, pi.filters, 1)
+	assert.False(t, pi.filters[0].Enabled)
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		policy string
+		err    string
+	}{
+		{"- rule: r1\n  desc: d\n  condition: sf.proc.name = bash )\n", `:3:34: rule "r1": unexpected token in condition (near ")")`},
+		{"- rule: r1\n  desc: d\n  condition: sf.proc.name = bash and\n    (sf.proc.exe = x or\n    sf.proc.exe =)\n", `:5:18: rule "r1": missing`},
+		{"- rule: r1\n  desc: d\n  condition: |\n    sf.proc.name = bash and\n      sf.proc.exe in ()\n", `:5:23: rule "r1": mismatched input ')'`},
+		{"- macro: m1\n  condition: sf.proc.name = bash\n  items: [a]\n", `:3:3: macro "m1": unknown attribute "items"`},
+		{"- rule: r1\n  condition: sf.proc.name = bash\n", `:1:3: rule "r1": missing attribute "desc"`},
+		{"- lst: l1\n  items: [a]\n", `:1:3: unknown declaration "lst"`},
+		{"- rule: r1\n  desc: d\n  condition: sf.proc.name = bash\n- rule: r1\n  desc: d\n  condition: sf.proc.name = sh\n", `:4:9: rule "r1": duplicate rule name`},
+		{"- filter: f1\n  condition: sf.proc.name = bash\n- drop: f1\n  condition: sf.proc.name = sh\n", `:3:9: drop "f1": duplicate filter name`},
+		{"- list: l1\n  items: [a]\n - list: l2\n  items: [b]\n", `yaml: line 2`},
+	}
+	for _, test := range tests {
+		_, errs := compilePolicy(t, Config{}, test.policy)
+		if assert.NotEmpty(t, errs, test.policy) {
+			assert.Contains(t, errs[0].Error(), test.err)
+		}
+	}
+}
+
+func TestCompileAppend(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{}, `
+- list: shells
+  items: [bash]
+- list: shells
+  items: [sh]
+  append: true
+- macro: spawned_shell
+  condition: sf.opflags = EXEC
+- macro: spawned_shell
+  condition: sf.proc.name in (shells)
+  append: true
+`)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"sh"}, pi.lists["shells"])
+	assert.Equal(t, "sf.proc.namein(shells)", pi.macroCtxs["spawned_shell"].GetText())
+}
+
+func TestExplainConfig(t *testing.T) {
+	for v, explain := range map[string]bool{"true": true, "True": true, "1": true, "false": false, "0": false} {
+		c, err := CreateConfig(map[string]interface{}{ExplainKey: v})
+		assert.NoError(t, err)
+		assert.Equal(t, explain, c.Explain, v)
+	}
+	_, err := CreateConfig(map[string]interface{}{ExplainKey: "yes"})
+	assert.Error(t, err)
+}
+
+func TestExplain(t *testing.T) {
+	policy := `
+- list: shells
+  items: [bash, sh]
+
+- macro: shell_parent
+  condition: sf.proc.aname[1] in (shells)
+
+- rule: Shell child
+  desc: process spawned by a shell
+  condition: (sf.proc.aname[0] = vim or sf.proc.adepth > 2) and shell_parent and not sf.proc.aname[2] = cron
+
+- rule: Vim
+  desc: vim process
+  condition: sf.proc.aname[0] = vim and shell_parent
+`
+	pi, errs := compilePolicy(t, Config{Mode: EnrichMode, Explain: true}, policy)
+	assert.Empty(t, errs)
+
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Fr.Ptree = []*sfgo.Process{
+		{Exe: "/usr/bin/cat", Oid: &sfgo.OID{Hpid: 30}},
+		{Exe: "/bin/bash", Oid: &sfgo.OID{Hpid: 20}},
+		{Exe: "/usr/sbin/sshd", Oid: &sfgo.OID{Hpid: 10}},
+		{Exe: "/sbin/init", Oid: &sfgo.OID{Hpid: 1}},
+	}
+	assert.NotNil(t, pi.Process(r))
+	assert.Equal(t, []RuleExplanation{{
+		Rule: "Shell child",
+		Explanations: []Explanation{
+			{Expr: "sf.proc.adepth > 2", Operands: []Operand{{Attr: "sf.proc.adepth", Value: "3"}}},
+			{Macro: "shell_parent", Expr: "sf.proc.aname[1] in (shells)", Operands: []Operand{{Attr: "sf.proc.aname[1]", Value: "bash"}}},
+			{Expr: "not sf.proc.aname[2] = cron"},
+		},
+	}}, r.Ctx.GetExplanations())
+
+	pi, _ = compilePolicy(t, Config{Mode: EnrichMode}, policy)
+	r = NewRecord(r.Fr)
+	assert.NotNil(t, pi.Process(r))
+	assert.Nil(t, r.Ctx.GetExplanations())
+}
+
+func TestCoverage(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{Mode: EnrichMode, Coverage: "coverage.txt"}, `
+- macro: shell_parent
+  condition: sf.proc.aname[1] in (bash, sh)
+
+- macro: cron_parent
+  condition: sf.proc.aname[1] = cron
+
+- rule: Shell child
+  desc: process spawned by a shell
+  condition: (sf.proc.aname[0] = vim or sf.proc.adepth > 2) and shell_parent
+`)
+	assert.Empty(t, errs)
+
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Fr.Ptree = []*sfgo.Process{
+		{Exe: "/usr/bin/cat", Oid: &sfgo.OID{Hpid: 30}},
+		{Exe: "/bin/bash", Oid: &sfgo.OID{Hpid: 20}},
+		{Exe: "/usr/sbin/sshd", Oid: &sfgo.OID{Hpid: 10}},
+		{Exe: "/sbin/init", Oid: &sfgo.OID{Hpid: 1}},
+	}
+	pi.Process(r)
+	pi.Process(NewRecord(sfgo.FlatRecord{}))
+
+	cov := pi.Coverage()
+	assert.Len(t, cov.Rules, 1)
+	assert.Equal(t, uint64(2), cov.Rules[0].Evaluated)
+	assert.Equal(t, uint64(1), cov.Rules[0].Matched)
+	assert.Equal(t, []string{"cron_parent"}, cov.DeadMacros())
+
+	var b strings.Builder
+	assert.NoError(t, cov.WriteReport(&b))
+	assert.Contains(t, b.String(), "Shell child  2          1")
+	assert.Contains(t, b.String(), "Never-true predicates in rule \"Shell child\":\n  sf.proc.aname[0] = vim\n")
+	assert.Contains(t, b.String(), "Dead macros:\n  cron_parent\n")
+}
+
+func TestDependencyGraph(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{}, `
+- list: shells
+  items: [bash, sh]
+
+- list: interpreters
+  items: [shells, python]
+
+- macro: interpreter_parent
+  condition: sf.proc.aname[1] in (interpreters)
+
+- macro: spawned_by_interpreter
+  condition: interpreter_parent and sf.type = PE
+
+- rule: Interpreter child
+  desc: process spawned by an interpreter
+  condition: spawned_by_interpreter and sf.proc.exe != /usr/bin/vim
+
+- rule: Shell exec
+  desc: shell executed
+  condition: sf.proc.name in (shells)
+
+- filter: no_cron
+  condition: sf.proc.name = cron
+`)
+	assert.Empty(t, errs)
+
+	g := pi.DependencyGraph()
+	assert.Equal(t, &DepNode{Macros: []string{"spawned_by_interpreter"}, Fields: []string{"sf.proc.exe"}}, g.Rules["Interpreter child"])
+	assert.Equal(t, []string{"interpreters"}, g.Macros["interpreter_parent"].Lists)
+	assert.Equal(t, []string{"sf.proc.aname[1]"}, g.Macros["interpreter_parent"].Fields)
+	assert.Equal(t, []string{"shells"}, g.Lists["interpreters"].Lists)
+	assert.Equal(t, []string{"sf.proc.name"}, g.Filters["no_cron"].Fields)
+
+	assert.Equal(t, []string{"Interpreter child", "Shell exec"}, g.Affected("shells"))
+	assert.Equal(t, []string{"Interpreter child"}, g.Affected("interpreter_parent"))
+	assert.Empty(t, g.Affected("no_such_macro"))
+
+	var b strings.Builder
+	assert.NoError(t, g.WriteDOT(&b))
+	assert.Contains(t, b.String(), "\"rule:Shell exec\" -> \"list:shells\";\n")
+	assert.Contains(t, b.String(), "\"list:interpreters\" -> \"list:shells\";\n")
+	assert.Contains(t, b.String(), "\"macro:spawned_by_interpreter\" -> \"macro:interpreter_parent\";\n")
+}
+
+func TestAffectedNestedMacros(t *testing.T) {
+	pi := NewPolicyInterpreter(Config{}, nil)
+	assert.NoError(t, pi.Compile("../../../resources/policies/tests/unit_test_macro.yaml"))
+	g := pi.DependencyGraph()
+	assert.Equal(t, []string{"in_node_container", "python_exe"}, g.Macros["node_python"].Macros)
+	// rules are searched in map order, so repeat to cover macros shared between searches
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, []string{"Nested macros rule to test if Python process"}, g.Affected("python_binaries"))
+		assert.Equal(t, []string{"Exec in node container", "Nested macros rule to test if Python process"}, g.Affected("in_node_container"))
+		assert.Equal(t, []string{"Nested macros rule to test if Python process", "Simple rule to test if Python process"}, g.Affected("is_python"))
+	}
+	assert.Empty(t, g.Affected("files"))
+}
+
+func TestParameterizedMacros(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{Mode: AlertMode}, `
+- list: shells
+  items: [bash, sh]
+
+- macro: parent_in(names)
+  condition: sf.proc.aname[1] in ($names)
+
+- macro: spawned_by(exe, names)
+  condition: sf.proc.aexe[0] startswith $exe and parent_in($names)
+
+- rule: Cat from shell
+  desc: cat spawned by a shell
+  condition: spawned_by("/usr/bin/", shells)
+
+- rule: Cat from cron
+  desc: cat spawned by cron
+  condition: spawned_by("/usr/bin/", cron)
+`)
+	assert.Empty(t, errs)
+
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Fr.Ptree = []*sfgo.Process{
+		{Exe: "/usr/bin/cat", Oid: &sfgo.OID{Hpid: 30}},
+		{Exe: "/bin/bash", Oid: &sfgo.OID{Hpid: 20}},
+	}
+	pi.Process(r)
+	if rules := r.Ctx.GetRules(); assert.Len(t, rules, 1) {
+		assert.Equal(t, "Cat from shell", rules[0].Name)
+	}
+
+	g := pi.DependencyGraph()
+	assert.Equal(t, []string{"spawned_by"}, g.Rules["Cat from shell"].Macros)
+	assert.Equal(t, []string{"Cat from cron", "Cat from shell"}, g.Affected("parent_in"))
+}
+
+func TestParameterizedMacroErrors(t *testing.T) {
+	macro := "- macro: under(dir)\n  condition: sf.file.path startswith $dir\n"
+	tests := []struct {
+		policy string
+		err    string
+	}{
+		{macro + "- rule: r1\n  desc: d\n  condition: under(/etc, /var)\n", `:5:14: rule "r1": macro under expects 1 arguments, got 2 (near "under")`},
+		{macro + "- rule: r1\n  desc: d\n  condition: sf.proc.name = bash and under\n", `:5:38: rule "r1": macro under expects 1 arguments, got 0 (near "under")`},
+		{macro + "- rule: r1\n  desc: d\n  condition: over(/etc)\n", `:5:14: rule "r1": unknown macro over (near "over")`},
+		{"- macro: under(dir)\n  condition: sf.file.path startswith $path\n", `:2:38: macro "under(dir)": undefined macro parameter (near "$path")`},
+		{"- rule: r1\n  desc: d\n  condition: sf.file.path startswith $dir\n", `:3:38: rule "r1": undefined macro parameter (near "$dir")`},
+		{"- macro: under(dir, dir)\n  condition: sf.file.path startswith $dir\n", `:1:10: macro "under(dir, dir)": duplicate macro parameter "dir"`},
+	}
+	for _, test := range tests {
+		_, errs := compilePolicy(t, Config{}, test.policy)
+		if assert.NotEmpty(t, errs, test.policy) {
+			assert.Contains(t, errs[0].Error(), test.err)
+		}
+	}
+}
+
+func TestRecursiveMacros(t *testing.T) {
+	rule := "- rule: r1\n  desc: d\n  condition: m1\n"
+	tests := []struct {
+		policy string
+		err    string
+	}{
+		{"- macro: m1\n  condition: sf.proc.name = bash or m1\n" + rule, `:2:37: macro "m1": recursive macro m1 (near "m1")`},
+		{"- macro: m1\n  condition: sf.proc.name = bash or m2\n- macro: m2\n  condition: not m1\n" + rule, `:4:18: macro "m2": recursive macro m1 (near "m1")`},
+		{"- macro: m1(x)\n  condition: sf.proc.name = $x or m1($x)\n- rule: r1\n  desc: d\n  condition: m1(bash)\n", `:2:35: macro "m1(x)": recursive macro m1 (near "m1")`},
+	}
+	for _, test := range tests {
+		_, errs := compilePolicy(t, Config{}, test.policy)
+		if assert.Len(t, errs, 1, test.policy) {
+			assert.Contains(t, errs[0].Error(), test.err)
+		}
+	}
+}
+
+func TestProcFlowAndNetEvt(t *testing.T) {
+	pi, errs := compilePolicy(t, Config{Mode: AlertMode}, `
+- rule: Failed connect
+  desc: failed outbound connection
+  condition: sf.type = NE and sf.opflags = CONNECT and sf.net.dport = 443 and sf.ret < 0
+
+- rule: Clone errors
+  desc: process failed to clone threads
+  condition: sf.type = PF and sf.pf.cloneerrors > 0 and sf.endts > 150
+
+- rule: Flow reads
+  desc: flow with read operations
+  condition: sf.flow.rops > 0
+`)
+	assert.Empty(t, errs)
+
+	newRecord := func(rtype int64) *Record {
+		fr := sfgo.FlatRecord{
+			Sources: []sfgo.Source{sfgo.SYSFLOW_SRC},
+			Ints:    [][]int64{make([]int64, layout.INT_ARRAY_SIZE)},
+			Strs:    [][]string{make([]string, sfgo.STR_ARRAY_SIZE)},
+			Anys:    [][]interface{}{make([]interface{}, sfgo.ANY_ARRAY_SIZE)},
+		}
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = rtype
+		return NewRecord(fr)
+	}
+
+	ne := newRecord(sfgo.NET_EVT)
+	ne.Fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_OPFLAGS_INT] = sfgo.OP_CONNECT
+	ne.Fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_RET_INT] = -111
+	ne.Fr.Ints[sfgo.SYSFLOW_IDX][layout.EV_NET_DPORT_INT] = 443
+	pi.Process(ne)
+	if rules := ne.Ctx.GetRules(); assert.Len(t, rules, 1) {
+		assert.Equal(t, "Failed connect", rules[0].Name)
+	}
+	assert.Equal(t, int64(-111), Mapper.MapInt(SF_RET)(ne))
+
+	pf := newRecord(sfgo.PROC_FLOW)
+	pf.Fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_TS_INT] = 100
+	pf.Fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_ENDTS_INT] = 200
+	pf.Fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMTHREADSCLONED_INT] = 3
+	pf.Fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMTHREADSEXITED_INT] = 1
+	pf.Fr.Ints[sfgo.SYSFLOW_IDX][layout.FL_PROC_NUMCLONEERRORS_INT] = 2
+	pi.Process(pf)
+	if rules := pf.Ctx.GetRules(); assert.Len(t, rules, 1) {
+		assert.Equal(t, "Clone errors", rules[0].Name)
+	}
+	assert.Equal(t, sfgo.TyPFStr, Mapper.MapStr(SF_TYPE)(pf))
+	assert.Equal(t, int64(3), Mapper.MapInt(SF_PF_THREADSCLONED)(pf))
+	assert.Equal(t, int64(0), Mapper.MapInt(SF_FLOW_ROPS)(pf))
+	assert.Equal(t, int64(0), Mapper.MapInt(SF_FLOW_WOPS)(pf))
+	assert.Equal(t, int64(0), Mapper.MapInt(SF_FILE_FD)(pf))
+	assert.Equal(t, int64(0), Mapper.MapInt(SF_ENDTS)(ne))
+
+	nf := newRecord(sfgo.NET_FLOW)
+	nf.Fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_NUMRRECVOPS_INT] = 4
+	pi.Process(nf)
+	if rules := nf.Ctx.GetRules(); assert.Len(t, rules, 1) {
+		assert.Equal(t, "Flow reads", rules[0].Name)
+	}
+	assert.Equal(t, int64(4), Mapper.MapInt(SF_FLOW_ROPS)(nf))
+	assert.Equal(t, int64(0), Mapper.MapInt(SF_PF_THREADSCLONED)(nf))
+}
+
+func TestSummaries(t *testing.T) {
+	policy := `
+- rule: Flow reads
+  desc: flow with read operations
+  condition: sf.flow.rops > 0
+`
+	summary := func() *Record {
+		fr := sfgo.FlatRecord{
+			Sources: []sfgo.Source{sfgo.SYSFLOW_SRC},
+			Ints:    [][]int64{make([]int64, layout.INT_ARRAY_SIZE)},
+			Strs:    [][]string{make([]string, sfgo.STR_ARRAY_SIZE)},
+			Anys:    [][]interface{}{make([]interface{}, sfgo.ANY_ARRAY_SIZE)},
+		}
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.SF_REC_TYPE] = sfgo.NET_FLOW
+		fr.Ints[sfgo.SYSFLOW_IDX][sfgo.FL_NETW_NUMRRECVOPS_INT] = 4
+		fr.Ints[sfgo.SYSFLOW_IDX][layout.DEDUP_SUMMARY_INT] = 1
+		fr.Ints[sfgo.SYSFLOW_IDX][layout.DEDUP_COUNT_INT] = 2
+		return NewRecord(fr)
+	}
+
+	pi, errs := compilePolicy(t, Config{Mode: AlertMode}, policy)
+	assert.Empty(t, errs)
+	r := summary()
+	assert.True(t, r.IsSummary())
+	assert.Nil(t, pi.Process(r), "summaries never match rules")
+	assert.Empty(t, r.Ctx.GetRules())
+
+	pi, errs = compilePolicy(t, Config{Mode: EnrichMode}, policy)
+	assert.Empty(t, errs)
+	r = summary()
+	assert.Equal(t, r, pi.Process(r), "summaries are forwarded as is")
+	assert.Empty(t, r.Ctx.GetRules())
+}
@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
+)
+
+// paramPrefix marks references to macro parameters in conditions.
+const paramPrefix = "$"
+
+var (
+	signaturere = regexp.MustCompile(`^([^\s(]+)\s*\((.*)\)$`)
+	paramre     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// macroDecl holds the declaration of a macro.
+type macroDecl struct {
+	params []string
+	pf     *policyFile
+	d      *policyDecl
+}
+
+// macroScope holds the declaration whose condition is being compiled, the name of
+// the macro being expanded, and the arguments bound to its parameters if it is a
+// parameterized macro.
+type macroScope struct {
+	pf    *policyFile
+	d     *policyDecl
+	macro string
+	args  map[string]string
+}
+
+// signature splits the name of macro declaration d into the macro name and its parameters.
+func (pf *policyFile) signature(d *policyDecl) (string, []string) {
+	m := signaturere.FindStringSubmatch(d.Name())
+	if m == nil {
+		return d.Name(), nil
+	}
+	var params []string
+	for _, p := range strings.Split(m[2], ",") {
+		p = strings.TrimSpace(p)
+		if !paramre.MatchString(p) {
+			pf.errorf(d.name, d, "invalid macro parameter %q", p)
+			continue
+		}
+		if contains(params, p) {
+			pf.errorf(d.name, d, "duplicate macro parameter %q", p)
+			continue
+		}
+		params = append(params, p)
+	}
+	return m[1], params
+}
+
+// checkParams reports references to undeclared parameters in the condition ctx of declaration d.
+func (pf *policyFile) checkParams(d *policyDecl, ctx antlr.Tree, params []string) {
+	if a, ok := ctx.(*parser.AtomContext); ok {
+		if p := a.GetText(); strings.HasPrefix(p, paramPrefix) && !contains(params, p[len(paramPrefix):]) {
+			t := a.GetStart()
+			pf.errorAt(d.attrs[keyCond], d, t.GetLine(), t.GetColumn(), p, "undefined macro parameter")
+		}
+		return
+	}
+	for _, c := range ctx.GetChildren() {
+		pf.checkParams(d, c, params)
+	}
+}
+
+// macroCall returns the macro name and arguments of term ctx if it is a macro call.
+func macroCall(ctx *parser.TermContext) (string, []parser.IAtomContext, bool) {
+	c := ctx.Macro_call()
+	if c == nil {
+		return "", nil, false
+	}
+	call := c.(*parser.Macro_callContext)
+	return call.ID().GetText(), call.AllAtom(), true
+}
+
+// macroRef returns the name of the macro referenced or called by term ctx, if any.
+func macroRef(ctx *parser.TermContext) (string, bool) {
+	if ctx.Variable() != nil {
+		return ctx.GetText(), true
+	}
+	name, _, ok := macroCall(ctx)
+	return name, ok
+}
+
+// atom returns the text of atom ctx, substituting macro parameters with their arguments.
+func (pi *PolicyInterpreter) atom(ctx parser.IAtomContext) string {
+	s := ctx.GetText()
+	if strings.HasPrefix(s, paramPrefix) && len(pi.scopes) > 0 {
+		if v, ok := pi.scopes[len(pi.scopes)-1].args[s[len(paramPrefix):]]; ok {
+			return v
+		}
+	}
+	return s
+}
+
+// compileScoped compiles a condition within scope s.
+func (pi *PolicyInterpreter) compileScoped(s *macroScope, compile func() Criterion) Criterion {
+	pi.scopes = append(pi.scopes, s)
+	defer func() { pi.scopes = pi.scopes[:len(pi.scopes)-1] }()
+	return compile()
+}
+
+// expandMacro compiles the macro referenced or called by term ctx, binding its parameters
+// to the call arguments.
+func (pi *PolicyInterpreter) expandMacro(ctx *parser.TermContext, name string, args []parser.IAtomContext) Criterion {
+	m, ok := pi.macros[name]
+	if !ok {
+		pi.errorf(ctx.GetStart(), "unknown macro %s", name)
+		return False
+	}
+	if len(args) != len(m.params) || len(ctx.AllItems()) > 0 {
+		pi.errorf(ctx.GetStart(), "macro %s expects %d arguments, got %d", name, len(m.params), len(args)+len(ctx.AllItems()))
+		return False
+	}
+	for _, s := range pi.scopes {
+		if s.macro == name {
+			pi.errorf(ctx.GetStart(), "recursive macro %s", name)
+			return False
+		}
+	}
+	s := &macroScope{pf: m.pf, d: m.d, macro: name, args: make(map[string]string, len(args))}
+	for i, p := range m.params {
+		s.args[p] = pi.atom(args[i])
+	}
+	return pi.compileScoped(s, func() Criterion { return pi.visitExpression(pi.macroCtxs[name]) })
+}
+
+// errorf records a compilation error at token t of the condition being compiled.
+func (pi *PolicyInterpreter) errorf(t antlr.Token, format string, args ...interface{}) {
+	s := pi.scopes[len(pi.scopes)-1]
+	n := s.d.attrs[keyCond]
+	e := s.pf.newError(n, s.d, fmt.Sprintf(format, args...))
+	e.Line, e.Column = s.pf.locate(n, t.GetLine(), t.GetColumn())
+	e.Token = t.GetText()
+	pi.pf.errs = append(pi.pf.errs, e)
+}
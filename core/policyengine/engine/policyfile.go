@@ -0,0 +1,407 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sysflow-telemetry/sf-apis/go/logger"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/errorhandler"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/lang/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// Policy declaration kinds.
+const (
+	declRule   = "rule"
+	declFilter = "filter"
+	declDrop   = "drop"
+	declMacro  = "macro"
+	declList   = "list"
+	declReq    = "required_engine_version"
+)
+
+// Policy declaration attributes.
+const (
+	keyDesc         = "desc"
+	keyCond         = "condition"
+	keyItems        = "items"
+	keyOutput       = "output"
+	keyActions      = "actions"
+	keyPriority     = "priority"
+	keyTags         = "tags"
+	keyPrefilter    = "prefilter"
+	keyEnabled      = "enabled"
+	keyWarnEvtTypes = "warn_evttypes"
+	keySkipUnknown  = "skip-if-unknown-filter"
+	keyAppend       = "append"
+)
+
+// declSpec lists the required and optional attributes of a declaration kind.
+type declSpec struct {
+	required []string
+	optional []string
+}
+
+// Supported declaration kinds.
+var declSpecs = map[string]declSpec{
+	declRule: {
+		required: []string{keyDesc, keyCond},
+		optional: []string{keyOutput, keyActions, keyPriority, keyTags, keyPrefilter, keyEnabled, keyWarnEvtTypes, keySkipUnknown},
+	},
+	declFilter: {required: []string{keyCond}, optional: []string{keyEnabled}},
+	declDrop:   {required: []string{keyCond}, optional: []string{keyEnabled}},
+	declMacro:  {required: []string{keyCond}, optional: []string{keyAppend}},
+	declList:   {required: []string{keyItems}, optional: []string{keyAppend}},
+	declReq:    {},
+}
+
+// policyDecl is a top-level declaration (rule, filter, macro, list) of a policy file.
+type policyDecl struct {
+	kind  string
+	name  *yaml.Node
+	attrs map[string]*yaml.Node
+}
+
+// Name returns the name of the declaration.
+func (d *policyDecl) Name() string {
+	return d.name.Value
+}
+
+// String returns a description of the declaration used in error messages.
+func (d *policyDecl) String() string {
+	return fmt.Sprintf("%s %q", d.kind, d.name.Value)
+}
+
+// policyFile is a policy file parsed into YAML declarations.
+type policyFile struct {
+	path  string
+	lines []string
+	decls []*policyDecl
+	errs  []error
+}
+
+// loadPolicyFile reads and parses the policy file in path. Syntax errors are
+// accumulated in the errs field of the returned policy file.
+func loadPolicyFile(path string) (*policyFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pf := &policyFile{path: path, lines: strings.Split(string(data), "\n")}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err == io.EOF {
+			break
+		} else if err != nil {
+			pf.errs = append(pf.errs, &errorhandler.PolicyError{File: path, Msg: err.Error()})
+			break
+		}
+		pf.parseDocument(&doc)
+	}
+	return pf, nil
+}
+
+func (pf *policyFile) parseDocument(doc *yaml.Node) {
+	if len(doc.Content) == 0 {
+		return
+	}
+	root := resolve(doc.Content[0])
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return
+	}
+	if root.Kind != yaml.SequenceNode {
+		pf.errorf(root, nil, "expected a sequence of policy declarations")
+		return
+	}
+	for _, n := range root.Content {
+		pf.parseDecl(resolve(n))
+	}
+}
+
+func (pf *policyFile) parseDecl(n *yaml.Node) {
+	pairs := mappingPairs(n)
+	if n.Kind != yaml.MappingNode || len(pairs) == 0 {
+		pf.errorf(n, nil, "expected a rule, filter, macro or list declaration")
+		return
+	}
+	spec, ok := declSpecs[pairs[0].Value]
+	if !ok {
+		pf.errorf(pairs[0], nil, "unknown declaration %q", pairs[0].Value)
+		return
+	}
+	d := &policyDecl{kind: pairs[0].Value, name: resolve(pairs[1]), attrs: make(map[string]*yaml.Node)}
+	if d.name.Kind != yaml.ScalarNode {
+		pf.errorf(d.name, nil, "expected a name for %s declaration", d.kind)
+		return
+	}
+	for i := 2; i < len(pairs); i += 2 {
+		k, v := pairs[i], resolve(pairs[i+1])
+		if !contains(spec.required, k.Value) && !contains(spec.optional, k.Value) {
+			pf.errorf(k, d, "unknown attribute %q", k.Value)
+		} else if _, ok := d.attrs[k.Value]; ok {
+			pf.errorf(k, d, "duplicate attribute %q", k.Value)
+		} else {
+			d.attrs[k.Value] = v
+		}
+	}
+	valid := true
+	for _, k := range spec.required {
+		if _, ok := d.attrs[k]; !ok {
+			pf.errorf(n, d, "missing attribute %q", k)
+			valid = false
+		}
+	}
+	if valid && pf.appends(d) {
+		pf.warnf(d.attrs[keyAppend], d, "appending to a %s is not supported, the declaration replaces earlier ones", d.kind)
+	}
+	if valid {
+		pf.decls = append(pf.decls, d)
+	}
+}
+
+// parseCondition parses the condition of declaration d into an expression context.
+// It returns nil if the condition contains syntax errors.
+func (pf *policyFile) parseCondition(d *policyDecl) parser.IExpressionContext {
+	n := d.attrs[keyCond]
+	if n.Kind != yaml.ScalarNode || strings.TrimSpace(n.Value) == "" {
+		pf.errorf(n, d, "expected a condition expression")
+		return nil
+	}
+
+	// Create the Lexer
+	lexerErrors := &errorhandler.SfplErrorListener{}
+	lexer := parser.NewSfplLexer(antlr.NewInputStream(n.Value))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrors)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	// Create the Parser
+	parserErrors := &errorhandler.SfplErrorListener{}
+	p := parser.NewSfplParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(parserErrors)
+
+	// Parse the expression and check that it consumed the whole condition
+	ctx := p.Expression()
+	errs := append(lexerErrors.Errors, parserErrors.Errors...)
+	if t := stream.LT(1); len(errs) == 0 && t.GetTokenType() != antlr.TokenEOF {
+		pf.errorAt(n, d, t.GetLine(), t.GetColumn(), t.GetText(), "unexpected token in condition")
+		return nil
+	}
+	for _, e := range errs {
+		if se, ok := e.(*errorhandler.SfplSyntaxError); ok {
+			pf.errorAt(n, d, se.Line(), se.Column(), se.Token(), se.Msg())
+		} else {
+			pf.errorf(n, d, "%v", e)
+		}
+	}
+	if len(errs) > 0 {
+		return nil
+	}
+	return ctx
+}
+
+// scalar returns the value of attribute key of declaration d, if set.
+func (pf *policyFile) scalar(d *policyDecl, key string) (string, bool) {
+	n, ok := d.attrs[key]
+	if !ok {
+		return "", false
+	}
+	if n.Kind != yaml.ScalarNode {
+		pf.errorf(n, d, "expected a scalar value for %q", key)
+		return "", false
+	}
+	return n.Value, true
+}
+
+// list returns the items of sequence attribute key of declaration d.
+func (pf *policyFile) list(d *policyDecl, key string) []string {
+	items := make([]string, 0)
+	n, ok := d.attrs[key]
+	if !ok || n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return items
+	}
+	if n.Kind != yaml.SequenceNode {
+		pf.errorf(n, d, "expected a sequence for %q", key)
+		return items
+	}
+	for _, i := range n.Content {
+		i = resolve(i)
+		if i.Kind != yaml.ScalarNode {
+			pf.errorf(i, d, "expected a scalar item in %q", key)
+			continue
+		}
+		items = append(items, trimBoundingQuotes(i.Value))
+	}
+	return items
+}
+
+// enabled returns the enabled flag of declaration d (defaults to true).
+func (pf *policyFile) enabled(d *policyDecl) bool {
+	flag, ok := pf.scalar(d, keyEnabled)
+	if !ok {
+		return true
+	}
+	if b, err := strconv.ParseBool(trimBoundingQuotes(flag)); err == nil {
+		return b
+	}
+	pf.warnf(d.attrs[keyEnabled], d, "unrecognized enabled flag %s", flag)
+	return true
+}
+
+// appends returns the append flag of declaration d (defaults to false).
+func (pf *policyFile) appends(d *policyDecl) bool {
+	flag, ok := pf.scalar(d, keyAppend)
+	if !ok {
+		return false
+	}
+	if b, err := strconv.ParseBool(trimBoundingQuotes(flag)); err == nil {
+		return b
+	}
+	pf.warnf(d.attrs[keyAppend], d, "unrecognized append flag %s", flag)
+	return false
+}
+
+// priority returns the priority of declaration d (defaults to low).
+func (pf *policyFile) priority(d *policyDecl) Priority {
+	p, ok := pf.scalar(d, keyPriority)
+	if !ok {
+		return Low
+	}
+	if priority, ok := parsePriority(p); ok {
+		return priority
+	}
+	pf.warnf(d.attrs[keyPriority], d, "unrecognized priority value %s. Deferring to %s", p, Low.String())
+	return Low
+}
+
+func (pf *policyFile) newError(n *yaml.Node, d *policyDecl, msg string) *errorhandler.PolicyError {
+	e := &errorhandler.PolicyError{File: pf.path, Line: n.Line, Column: n.Column, Msg: msg}
+	if d != nil {
+		e.Decl = d.String()
+	}
+	return e
+}
+
+func (pf *policyFile) errorf(n *yaml.Node, d *policyDecl, format string, args ...interface{}) {
+	pf.errs = append(pf.errs, pf.newError(n, d, fmt.Sprintf(format, args...)))
+}
+
+func (pf *policyFile) warnf(n *yaml.Node, d *policyDecl, format string, args ...interface{}) {
+	logger.Warn.Println(pf.newError(n, d, fmt.Sprintf(format, args...)).Error())
+}
+
+// errorAt records an error found at line and column of the value of scalar node n.
+func (pf *policyFile) errorAt(n *yaml.Node, d *policyDecl, line, column int, token, msg string) {
+	e := pf.newError(n, d, msg)
+	e.Line, e.Column = pf.locate(n, line, column)
+	e.Token = token
+	pf.errs = append(pf.errs, e)
+}
+
+// locate maps a position (1-based line, 0-based column) in the value of scalar node n
+// to a position in the policy file. Line breaks of plain, quoted and folded scalars are
+// folded into single spaces by the YAML parser, so those are mapped by walking the source
+// lines holding the scalar.
+func (pf *policyFile) locate(n *yaml.Node, line, column int) (int, int) {
+	if n.Style&yaml.LiteralStyle != 0 {
+		l := n.Line + 1
+		for l < len(pf.lines) && strings.TrimSpace(pf.lines[l-1]) == "" {
+			l++
+		}
+		return n.Line + line, pf.indent(l) + column + 1
+	}
+	offset := column
+	for i, s := range strings.SplitN(n.Value, "\n", line) {
+		if i < line-1 {
+			offset += len(s) + 1
+		}
+	}
+	l, c := n.Line, n.Column
+	if n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+		c++
+	} else if n.Style&yaml.FoldedStyle != 0 {
+		l++
+		c = pf.indent(l) + 1
+	}
+	for l <= len(pf.lines) && c-1 <= len(pf.lines[l-1]) {
+		seg := strings.TrimRight(pf.lines[l-1][c-1:], " \t\r")
+		if offset <= len(seg) {
+			return l, c + offset
+		}
+		offset -= len(seg) + 1
+		for l++; l <= len(pf.lines) && strings.TrimSpace(pf.lines[l-1]) == ""; l++ {
+		}
+		c = pf.indent(l) + 1
+	}
+	return n.Line, n.Column
+}
+
+// indent returns the indentation of line l of the policy file.
+func (pf *policyFile) indent(l int) int {
+	if l < 1 || l > len(pf.lines) {
+		return 0
+	}
+	s := pf.lines[l-1]
+	return len(s) - len(strings.TrimLeft(s, " \t"))
+}
+
+// resolve follows YAML aliases to their anchored nodes.
+func resolve(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
+
+// mappingPairs returns the key/value nodes of mapping node n, expanding merge keys.
+func mappingPairs(n *yaml.Node) []*yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	pairs := make([]*yaml.Node, 0, len(n.Content))
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if k := n.Content[i]; k.Tag == "!!merge" {
+			pairs = append(pairs, mappingPairs(resolve(n.Content[i+1]))...)
+			continue
+		}
+		pairs = append(pairs, n.Content[i], n.Content[i+1])
+	}
+	return pairs
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
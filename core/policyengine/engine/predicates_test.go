@@ -91,3 +91,52 @@ func TestExists(t *testing.T) {
 	assert.Equal(t, false, Exists("sf.pproc.uid").Eval(r))
 	assert.Equal(t, false, Exists("sf.pproc.exe").Eval(r))
 }
+
+func TestAncestry(t *testing.T) {
+	r := NewRecord(sfgo.FlatRecord{})
+	assert.Equal(t, true, Eq("sf.proc.adepth", "0").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.aname[1]", "").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.anearest[sshd]", "-1").Eval(r))
+
+	r.Fr.Ptree = []*sfgo.Process{
+		{Exe: "/usr/bin/cat", ExeArgs: "/etc/shadow", Oid: &sfgo.OID{Hpid: 30}},
+		{Exe: "/bin/bash", Oid: &sfgo.OID{Hpid: 20}},
+		{Exe: "/usr/sbin/sshd", ExeArgs: "-D", Oid: &sfgo.OID{Hpid: 10}},
+		{Exe: "/sbin/init", Oid: &sfgo.OID{Hpid: 1}},
+	}
+	assert.Equal(t, true, Eq("sf.proc.adepth", "3").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.aname[0]", "cat").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.aname[2]", "sshd").And(Eq("sf.proc.aname[1]", "bash")).Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.aexe[3]", "/sbin/init").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.acmdline[2]", "/usr/sbin/sshd -D").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.apid[2]", "10").Eval(r))
+	assert.Equal(t, true, Eq("proc.aname[1]", "bash").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.aname[4]", "").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.anearest[sshd]", "2").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.anearest[/sbin/*]", "3").Eval(r))
+	assert.Equal(t, true, Eq("sf.proc.anearest[cat]", "-1").Eval(r))
+	assert.Equal(t, true, Gt("sf.proc.anearest[ssh*]", "0").Eval(r))
+	assert.Equal(t, true, In("sf.proc.aname", []string{"bash"}).Eval(r))
+}
+
+func TestExtended(t *testing.T) {
+	r := NewRecord(sfgo.FlatRecord{Sources: []sfgo.Source{sfgo.SYSFLOW_SRC}, Ints: [][]int64{nil}, Strs: [][]string{nil}})
+	assert.Equal(t, false, r.HasSource(sfgo.PROCESS_SRC))
+	assert.Equal(t, true, Eq("ext.proc.image", "").Eval(r))
+
+	strs := make([]string, sfgo.NUM_EXT_PROC_ATTRS_STR)
+	strs[sfgo.PROC_IMAGE_STR] = "/tmp/payload"
+	strs[sfgo.PROC_CURR_DIRECTORY_STR] = "/home/user/work"
+	strs[sfgo.PROC_SHA256_HASH_STR] = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	strs[sfgo.PROC_SIGNATURE_STATUS_STR] = "Unavailable"
+	r.Fr.Sources = append(r.Fr.Sources, sfgo.PROCESS_SRC)
+	r.Fr.Ints = append(r.Fr.Ints, make([]int64, sfgo.NUM_EXT_PROC_ATTRS_INT))
+	r.Fr.Strs = append(r.Fr.Strs, strs)
+	assert.Equal(t, true, r.HasSource(sfgo.PROCESS_SRC))
+	assert.Equal(t, true, StartsWith("ext.proc.image", "/tmp/").Eval(r))
+	assert.Equal(t, true, Eq("ext.proc.curdir", "/home/user").Eval(r))
+	assert.Equal(t, true, StartsWith("ext.proc.sha256", "2c26b4").Eval(r))
+	assert.Equal(t, true, Eq("ext.proc.signed", "0").And(Eq("ext.proc.sigstatus", "Unavailable")).Eval(r))
+	assert.Equal(t, true, Eq("ext.proc.md5", "").Eval(r))
+	assert.Equal(t, true, Eq("ext.file.sha256", "").Eval(r))
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Route selector kinds.
+const (
+	RoutePriority string = "priority"
+	RouteTag      string = "tag"
+	RouteRule     string = "rule"
+)
+
+// selector defines a functional type for matching the rules associated with a record.
+type selector func(rules []Rule) bool
+
+// Route defines a routing rule sending records matched by any of its selectors to an output channel.
+type Route struct {
+	Channel   string
+	selectors []selector
+}
+
+// Matches checks whether any of the route's selectors matches the rules associated with record r.
+func (rt Route) Matches(r *Record) bool {
+	rules := r.Ctx.GetRules()
+	if len(rules) == 0 {
+		return false
+	}
+	for _, sel := range rt.selectors {
+		if sel(rules) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRoutes creates routes from 'route.<channel>' configuration attributes.
+// Route values are comma-separated lists of selectors of the form 'priority:<level>', 'tag:<pattern>' or 'rule:<pattern>'.
+// SysFlow priorities (low, medium, high) are compared on the three-level scale, while Falco priorities
+// are compared on the full severity scale, so that 'priority:critical' does not match error rules.
+// Malformed selectors are reported as errors.
+func parseRoutes(conf map[string]interface{}) ([]Route, error) {
+	var routes []Route
+	for k, v := range conf {
+		s, ok := v.(string)
+		if !ok || !strings.HasPrefix(k, RoutePrefixKey) {
+			continue
+		}
+		rt := Route{Channel: strings.TrimPrefix(k, RoutePrefixKey)}
+		for _, expr := range strings.Split(s, LISTSEP) {
+			sel, err := parseSelector(strings.TrimSpace(expr))
+			if err != nil {
+				return nil, fmt.Errorf("attribute '%s': %v", k, err)
+			}
+			rt.selectors = append(rt.selectors, sel)
+		}
+		routes = append(routes, rt)
+	}
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Channel < routes[j].Channel })
+	return routes, nil
+}
+
+// parseSelector creates a selector from a selector expression.
+func parseSelector(expr string) (selector, error) {
+	kv := strings.SplitN(expr, ":", 2)
+	if len(kv) != 2 || len(kv[1]) == 0 {
+		return nil, fmt.Errorf("malformed selector '%s'", expr)
+	}
+	arg := kv[1]
+	switch kv[0] {
+	case RoutePriority:
+		p, ok := parsePriority(arg)
+		if !ok {
+			return nil, fmt.Errorf("unrecognized priority value '%s'", arg)
+		}
+		falco := p > High
+		return func(rules []Rule) bool {
+			for _, r := range rules {
+				if falco && r.Priority.Severity() >= p.Severity() || !falco && r.Priority.Level() >= p.Level() {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case RouteTag:
+		if _, err := path.Match(arg, EMPTY); err != nil {
+			return nil, fmt.Errorf("malformed tag pattern '%s'", arg)
+		}
+		return func(rules []Rule) bool {
+			for _, r := range rules {
+				for _, tag := range ruleTags(r) {
+					if ok, _ := path.Match(arg, tag); ok {
+						return true
+					}
+				}
+			}
+			return false
+		}, nil
+	case RouteRule:
+		if _, err := path.Match(arg, EMPTY); err != nil {
+			return nil, fmt.Errorf("malformed rule pattern '%s'", arg)
+		}
+		return func(rules []Rule) bool {
+			for _, r := range rules {
+				if ok, _ := path.Match(arg, r.Name); ok {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+	return nil, fmt.Errorf("unrecognized selector '%s'", kv[0])
+}
+
+// ruleTags returns the list of tags of rule r.
+func ruleTags(r Rule) []string {
+	var tags []string
+	for _, tag := range r.Tags {
+		switch tag := tag.(type) {
+		case []string:
+			tags = append(tags, tag...)
+		case string:
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func TestRoutes(t *testing.T) {
+	routes, err := parseRoutes(map[string]interface{}{
+		"route.pager": "priority:high, tag:mitre:*",
+		"route.audit": "rule:Audit*",
+		"policies":    "../resources/policies",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(routes))
+	assert.Equal(t, "audit", routes[0].Channel)
+	assert.Equal(t, "pager", routes[1].Channel)
+
+	r := NewRecord(sfgo.FlatRecord{})
+	assert.Equal(t, false, routes[1].Matches(r))
+
+	r.Ctx.AddRule(Rule{Name: "Audit shell", Priority: Low, Tags: []EnrichmentTag{[]string{"test"}}})
+	assert.Equal(t, true, routes[0].Matches(r))
+	assert.Equal(t, false, routes[1].Matches(r))
+
+	r.Ctx.AddRule(Rule{Name: "Reverse shell", Priority: Medium, Tags: []EnrichmentTag{[]string{"mitre:T1059"}}})
+	assert.Equal(t, true, routes[1].Matches(r))
+
+	r = NewRecord(sfgo.FlatRecord{})
+	r.Ctx.AddRule(Rule{Name: "Privilege escalation", Priority: High})
+	assert.Equal(t, false, routes[0].Matches(r))
+	assert.Equal(t, true, routes[1].Matches(r))
+	assert.Equal(t, Error.Level(), Critical.Level())
+	assert.Greater(t, Critical.Severity(), Error.Severity())
+	assert.Equal(t, Error.Severity(), High.Severity())
+}
+
+func TestMalformedRoutes(t *testing.T) {
+	for _, v := range []string{"priority:urgent", "severity:high", "tag:[", "rule:", "priority:high, tag:["} {
+		_, err := parseRoutes(map[string]interface{}{"route.siem": v})
+		assert.Error(t, err, v)
+		_, err = CreateConfig(map[string]interface{}{"route.siem": v})
+		assert.Error(t, err, v)
+	}
+}
+
+func TestFalcoPriorityRoutes(t *testing.T) {
+	routes, err := parseRoutes(map[string]interface{}{"route.pager": "priority:error"})
+	assert.NoError(t, err)
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Ctx.AddRule(Rule{Name: "Warning", Priority: Warning})
+	assert.Equal(t, false, routes[0].Matches(r))
+	r.Ctx.AddRule(Rule{Name: "Critical", Priority: Critical})
+	assert.Equal(t, true, routes[0].Matches(r))
+}
+
+func TestCriticalPriorityRoutes(t *testing.T) {
+	routes, err := parseRoutes(map[string]interface{}{"route.pager": "priority:critical", "route.ops": "priority:high"})
+	assert.NoError(t, err)
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Ctx.AddRule(Rule{Name: "Error", Priority: Error})
+	assert.Equal(t, false, routes[1].Matches(r))
+	assert.Equal(t, true, routes[0].Matches(r))
+	r.Ctx.AddRule(Rule{Name: "Critical", Priority: Critical})
+	assert.Equal(t, true, routes[1].Matches(r))
+	assert.Equal(t, Error.Level(), Critical.Level())
+	assert.Greater(t, Critical.Severity(), Error.Severity())
+	assert.Equal(t, Error.Severity(), High.Severity())
+}
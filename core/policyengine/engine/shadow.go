@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine implements a rules engine for telemetry records.
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RuleTally counts the records matched by each rule of a policy interpreter.
+type RuleTally struct {
+	mu     sync.Mutex
+	counts map[string]uint64
+}
+
+// NewRuleTally creates a new rule tally.
+func NewRuleTally() *RuleTally {
+	return &RuleTally{counts: make(map[string]uint64)}
+}
+
+// Add counts the rules matched by record r.
+func (t *RuleTally) Add(r *Record) {
+	rules := r.Ctx.GetRules()
+	if len(rules) == 0 {
+		return
+	}
+	t.mu.Lock()
+	for _, rule := range rules {
+		t.counts[rule.Name]++
+	}
+	t.mu.Unlock()
+}
+
+// Reset returns the counts collected since the last reset, and clears them.
+func (t *RuleTally) Reset() map[string]uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counts := t.counts
+	t.counts = make(map[string]uint64)
+	return counts
+}
+
+// RuleDelta denotes the number of records matched by a rule in the active and shadow policies.
+type RuleDelta struct {
+	Rule   string
+	Active uint64
+	Shadow uint64
+}
+
+// ShadowDiff summarizes the differences between the matches of the active and shadow policies.
+type ShadowDiff struct {
+	Active  uint64
+	Shadow  uint64
+	New     []RuleDelta
+	Stopped []RuleDelta
+	Changed []RuleDelta
+}
+
+// DiffRuleCounts compares rule match counts of active and shadow policies.
+func DiffRuleCounts(active, shadow map[string]uint64) ShadowDiff {
+	var d ShadowDiff
+	for rule, n := range active {
+		d.Active += n
+		if m, ok := shadow[rule]; !ok {
+			d.Stopped = append(d.Stopped, RuleDelta{Rule: rule, Active: n})
+		} else if m != n {
+			d.Changed = append(d.Changed, RuleDelta{Rule: rule, Active: n, Shadow: m})
+		}
+	}
+	for rule, m := range shadow {
+		d.Shadow += m
+		if _, ok := active[rule]; !ok {
+			d.New = append(d.New, RuleDelta{Rule: rule, Shadow: m})
+		}
+	}
+	for _, rds := range [][]RuleDelta{d.New, d.Stopped, d.Changed} {
+		sort.Slice(rds, func(i, j int) bool { return rds[i].Rule < rds[j].Rule })
+	}
+	return d
+}
+
+// Empty checks whether the active and shadow policies matched the same records.
+func (d ShadowDiff) Empty() bool {
+	return len(d.New) == 0 && len(d.Stopped) == 0 && len(d.Changed) == 0
+}
+
+// String returns a multi-line summary of the diff.
+func (d ShadowDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "active matches: %d, shadow matches: %d (%+d)", d.Active, d.Shadow, int64(d.Shadow)-int64(d.Active))
+	for _, rd := range d.New {
+		fmt.Fprintf(&b, "\n  new: %s (%d)", rd.Rule, rd.Shadow)
+	}
+	for _, rd := range d.Stopped {
+		fmt.Fprintf(&b, "\n  stopped: %s (%d)", rd.Rule, rd.Active)
+	}
+	for _, rd := range d.Changed {
+		fmt.Fprintf(&b, "\n  changed: %s %d -> %d (%+d)", rd.Rule, rd.Active, rd.Shadow, int64(rd.Shadow)-int64(rd.Active))
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func TestShadowDiff(t *testing.T) {
+	active, shadow := NewRuleTally(), NewRuleTally()
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Ctx.AddRule(Rule{Name: "Shell"})
+	r.Ctx.AddRule(Rule{Name: "Old"})
+	active.Add(r)
+	active.Add(r)
+	active.Add(NewRecord(sfgo.FlatRecord{}))
+
+	s := NewRecord(sfgo.FlatRecord{})
+	s.Ctx.AddRule(Rule{Name: "Shell"})
+	s.Ctx.AddRule(Rule{Name: "New"})
+	shadow.Add(s)
+
+	d := DiffRuleCounts(active.Reset(), shadow.Reset())
+	assert.Equal(t, uint64(4), d.Active)
+	assert.Equal(t, uint64(2), d.Shadow)
+	assert.Equal(t, []RuleDelta{{Rule: "New", Shadow: 1}}, d.New)
+	assert.Equal(t, []RuleDelta{{Rule: "Old", Active: 2}}, d.Stopped)
+	assert.Equal(t, []RuleDelta{{Rule: "Shell", Active: 2, Shadow: 1}}, d.Changed)
+	assert.Equal(t, "active matches: 4, shadow matches: 2 (-2)\n  new: New (1)\n  stopped: Old (2)\n  changed: Shell 2 -> 1 (-1)", d.String())
+
+	d = DiffRuleCounts(active.Reset(), shadow.Reset())
+	assert.True(t, d.Empty())
+}
+
+func TestShadowConfig(t *testing.T) {
+	for _, v := range []string{"0", "-30", "never"} {
+		_, err := CreateConfig(map[string]interface{}{ShadowKey: "/policies/candidate", ShadowIntervalKey: v})
+		assert.Error(t, err, v)
+	}
+	c, err := CreateConfig(map[string]interface{}{ModeConfigKey: "enrich", ExplainKey: "true", ShadowKey: "/policies/candidate", ShadowIntervalKey: "30"})
+	assert.NoError(t, err)
+
+	conf := c.ShadowConfig()
+	assert.Equal(t, "/policies/candidate", conf.PoliciesPath)
+	assert.Equal(t, AlertMode, conf.Mode)
+	assert.False(t, conf.Explain)
+	assert.True(t, conf.Shadow)
+}
+
+func TestShadowActions(t *testing.T) {
+	policy := `
+- rule: Vim
+  desc: vim process
+  condition: sf.proc.aname[0] = vim
+  actions: [count]
+`
+	calls := 0
+	count := func(r *Record) error { calls++; return nil }
+	r := NewRecord(sfgo.FlatRecord{})
+	r.Fr.Ptree = []*sfgo.Process{{Exe: "/usr/bin/vim", Oid: &sfgo.OID{Hpid: 30}}}
+
+	pi, errs := compilePolicy(t, Config{Mode: AlertMode}, policy)
+	assert.Empty(t, errs)
+	pi.ah.BuiltInActions["count"] = count
+	assert.NotNil(t, pi.Process(r))
+	assert.Equal(t, 1, calls)
+
+	pi, errs = compilePolicy(t, Config{Mode: AlertMode}.ShadowConfig(), policy)
+	assert.Empty(t, errs)
+	assert.Nil(t, pi.ah)
+	assert.NotNil(t, pi.Process(NewRecord(r.Fr)))
+	assert.Equal(t, 1, calls)
+}
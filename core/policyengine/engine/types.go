@@ -27,12 +27,20 @@ import (
 	"strings"
 
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener/layout"
 )
 
 // EnrichmentTag denotes the type for enrichment tags.
 type EnrichmentTag interface{}
 
 // Priority denotes the type for rule priority.
+// A priority keeps the level declared in the policy, which is either a SysFlow level (low, medium, high)
+// or a Falco level (debug, informational, notice, warning, error, critical, alert, emergency).
+// For backward compatibility, Level maps every priority onto the three-level SysFlow scale:
+//
+//	debug, informational, notice      -> low
+//	warning                           -> medium
+//	error, critical, alert, emergency -> high
 type Priority int
 
 // Priority enumeration.
@@ -40,11 +48,60 @@ const (
 	Low Priority = iota
 	Medium
 	High
+	Debug
+	Informational
+	Notice
+	Warning
+	Error
+	Critical
+	Alert
+	Emergency
 )
 
 // String returns the string representation of a priority instance.
 func (p Priority) String() string {
-	return [...]string{"low", "medium", "high"}[p]
+	return [...]string{"low", "medium", "high", FPriorityDebug, FPriorityInformational, FPriorityNotice,
+		FPriorityWarning, FPriorityError, FPriorityCritical, FPriorityAlert, FPriorityEmergency}[p]
+}
+
+// Level returns the priority mapped onto the three-level scale (low, medium, high).
+func (p Priority) Level() Priority {
+	return [...]Priority{Low, Medium, High, Low, Low, Low, Medium, High, High, High, High}[p]
+}
+
+// Severity returns the priority on the eight-level Falco scale, from debug (0) to emergency (7).
+// SysFlow levels map onto the scale as low -> notice, medium -> warning, high -> error.
+func (p Priority) Severity() int {
+	return [...]int{2, 3, 4, 0, 1, 2, 3, 4, 5, 6, 7}[p]
+}
+
+// parsePriority parses a priority value, accepting Falco priority values.
+func parsePriority(p string) (Priority, bool) {
+	switch strings.ToLower(p) {
+	case Low.String():
+		return Low, true
+	case Medium.String():
+		return Medium, true
+	case High.String():
+		return High, true
+	case FPriorityDebug:
+		return Debug, true
+	case FPriorityInfo, FPriorityInformational:
+		return Informational, true
+	case FPriorityNotice:
+		return Notice, true
+	case FPriorityWarning:
+		return Warning, true
+	case FPriorityError:
+		return Error, true
+	case FPriorityCritical:
+		return Critical, true
+	case FPriorityAlert:
+		return Alert, true
+	case FPriorityEmergency:
+		return Emergency, true
+	}
+	return Low, false
 }
 
 // Rule type
@@ -85,15 +142,31 @@ type Record struct {
 	Ctx Context
 }
 
-
 // NewRecord creates a new Record isntance.
 func NewRecord(fr sfgo.FlatRecord) *Record {
 	var r = new(Record)
 	r.Fr = fr
-	r.Ctx = make(Context, 4)
+	r.Ctx = make(Context, numCtxKeys)
 	return r
 }
 
+// IsSummary checks whether r summarizes records suppressed by the flattener's filter.
+// Summaries carry the attributes of a record already evaluated by the policies.
+func (r *Record) IsSummary() bool {
+	return getExtInt(r, layout.DEDUP_SUMMARY_INT, sfgo.SYSFLOW_SRC) == 1
+}
+
+// NewAlert creates a copy of record r whose context is flagged as an alert.
+// The flat record and the matched rules are shared with r.
+func NewAlert(r *Record) *Record {
+	var a = new(Record)
+	a.Fr = r.Fr
+	a.Ctx = make(Context, len(r.Ctx))
+	copy(a.Ctx, r.Ctx)
+	a.Ctx.SetAlert(true)
+	return a
+}
+
 // RecordChannel type
 type RecordChannel struct {
 	In chan *Record
@@ -118,8 +191,19 @@ const (
 	ProcAName
 	ProcACmdLine
 	ProcAPID
+	ProcADepth
 )
 
+// HasSource checks whether the internal flat record contains attributes from data source src.
+func (r Record) HasSource(src sfgo.Source) bool {
+	for _, s := range r.Fr.Sources {
+		if s == src {
+			return true
+		}
+	}
+	return false
+}
+
 // GetInt returns an integer value from internal flat record.
 func (r Record) GetInt(attr sfgo.Attribute, src sfgo.Source) int64 {
 	for idx, s := range r.Fr.Sources {
@@ -241,15 +325,58 @@ func (r Record) GetCachedValue(ID sfgo.OID, attr RecAttribute) interface{} {
 				s = append(s, strconv.FormatInt(p.Oid.Hpid, 10))
 			}
 			return strings.Join(s, LISTSEP)
+		case ProcADepth:
+			return int64(len(ptree) - 1)
 		}
 	}
 	switch attr {
-	case PProcUID, PProcGID, PProcTTY, PProcEntry:
+	case PProcUID, PProcGID, PProcTTY, PProcEntry, ProcADepth:
 		return sfgo.Zeros.Int64
 	}
 	return sfgo.Zeros.String
 }
 
+// GetAncestorValue returns the value of ancestry attribute attr for the process at depth i of the process tree.
+// Depth 0 denotes the process itself, depth 1 its parent, depth 2 its grandparent, and so on.
+func (r Record) GetAncestorValue(i int, attr RecAttribute) interface{} {
+	if ptree := r.Fr.Ptree; i >= 0 && i < len(ptree) {
+		p := ptree[i]
+		switch attr {
+		case ProcAName:
+			return filepath.Base(p.Exe)
+		case ProcAExe:
+			return p.Exe
+		case ProcACmdLine:
+			if len(p.ExeArgs) > 0 {
+				return p.Exe + SPACE + p.ExeArgs
+			}
+			return p.Exe
+		case ProcAPID:
+			return p.Oid.Hpid
+		}
+	}
+	if attr == ProcAPID {
+		return sfgo.Zeros.Int64
+	}
+	return sfgo.Zeros.String
+}
+
+// GetNearestAncestor returns the depth of the nearest ancestor whose name matches a glob pattern, or -1 if no ancestor matches.
+// Patterns containing a path separator are matched against the ancestor's executable path instead of its name.
+func (r Record) GetNearestAncestor(pattern string) int64 {
+	ptree := r.Fr.Ptree
+	for i := 1; i < len(ptree); i++ {
+		v := filepath.Base(ptree[i].Exe)
+		if strings.Contains(pattern, PATHSEP) {
+			v = ptree[i].Exe
+		}
+		if ok, _ := filepath.Match(pattern, v); ok {
+			return int64(i)
+		}
+	}
+	return -1
+}
+
 // Context denotes the type for contextual information obtained during rule processing.
 type Context []interface{}
 
@@ -262,6 +389,8 @@ const (
 	ruleCtxKey
 	tagCtxKey
 	hashCtxKey
+	explainCtxKey
+	numCtxKeys
 )
 
 func (s Context) IsAlert() bool {
@@ -332,6 +461,22 @@ func (s Context) SetHashes(ht HashType, hs *HashSet) {
 	}
 }
 
+// GetExplanations retrieves the explanations of the rules matching a record (explain mode only).
+func (s Context) GetExplanations() []RuleExplanation {
+	if s[explainCtxKey] != nil {
+		return s[explainCtxKey].(*explainTrace).rules
+	}
+	return nil
+}
+
+// trace retrieves the explanation trace of a record, creating it if needed.
+func (s Context) trace() *explainTrace {
+	if s[explainCtxKey] == nil {
+		s[explainCtxKey] = new(explainTrace)
+	}
+	return s[explainCtxKey].(*explainTrace)
+}
+
 type HashType uint
 
 const (
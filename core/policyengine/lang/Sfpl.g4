@@ -20,46 +20,6 @@ SKIPUNKNOWN: 'skip-if-unknown-filter';
 FAPPEND: 'append';
 REQ: 'required_engine_version';
 
-policy
-	: (prule | pfilter | pmacro | plist | preq)+ EOF
-	;
-
-defs
-	: (srule | sfilter | pmacro | plist | preq)* EOF
-	;
-
-prule			
-	: DECL RULE DEF text DESC DEF text COND DEF expression (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown)*
-	;
-
-srule
-	: DECL RULE DEF text DESC DEF text COND DEF expression (OUTPUT DEF text | ACTIONS DEF actions | PRIORITY DEF severity | TAGS DEF tags | PREFILTER DEF prefilter | ENABLED DEF enabled | WARNEVTTYPE DEF warnevttype | SKIPUNKNOWN DEF skipunknown)*
-	;
-
-pfilter
-	: DECL drop_keyword DEF ID COND DEF expression (ENABLED DEF enabled)?
-	;
-
-sfilter
-	: DECL drop_keyword DEF ID COND DEF expression (ENABLED DEF enabled)?
-	;
-
-drop_keyword
-	: DROP | FILTER
-	;
-
-pmacro
-	: DECL MACRO DEF ID COND DEF expression (FAPPEND DEF fappend)?
-	;
-
-plist
-	: DECL LIST DEF ID ITEMS DEF items 
-	;
-
-preq
-	: DECL REQ DEF atom
-	;
-	
 expression 
 	: or_expression 
 	;
@@ -79,44 +39,13 @@ term
 	| atom binary_operator atom 
 	| atom (IN|PMATCH) LPAREN (atom|items) (LISTSEP (atom|items))* RPAREN 
 	| LPAREN expression RPAREN
+	| macro_call
 	;
 
 items 
 	: LBRACK (atom (LISTSEP atom)*)? (LISTSEP)? RBRACK
 	;
 
-actions
-	: LBRACK (atom (LISTSEP atom)*)? (LISTSEP)? RBRACK
-	;
-
-tags
-	: LBRACK (atom (LISTSEP atom)*)? (LISTSEP)? RBRACK
-	;
-
-prefilter
-	: items
-	;
-
-severity
-	: SEVERITY
-	;
-
-enabled
-	: atom
-	;
-
-warnevttype
-	: atom
-	;
-
-skipunknown
-	: atom
-	;
-
-fappend
-	: atom
-	;
-
 variable
 	: ID
 	;		
@@ -131,20 +60,6 @@ atom
 	| '>' /* event direction */
 	;
 
-text
-	: ({!(p.GetCurrentToken().GetText() == "desc" ||
-	      p.GetCurrentToken().GetText() == "condition" ||
-	      p.GetCurrentToken().GetText() == "actions" ||
-	      p.GetCurrentToken().GetText() == "output" ||
-	      p.GetCurrentToken().GetText() == "priority" ||
-	      p.GetCurrentToken().GetText() == "tags" ||
-		  p.GetCurrentToken().GetText() == "prefilter" ||
-		  p.GetCurrentToken().GetText() == "enabled" ||
-		  p.GetCurrentToken().GetText() == "warn_evttypes" ||
-		  p.GetCurrentToken().GetText() == "skip-if-unknown-filter" ||
-		  p.GetCurrentToken().GetText() == "append" )}? .)+
-	;
-
 binary_operator 
 	: LT 
 	| LE 
@@ -162,6 +77,10 @@ unary_operator
 	: EXISTS
 	;
 
+macro_call
+	: ID LPAREN atom (LISTSEP atom)* RPAREN
+	;
+
 AND 
 	: 'and'
 	;
@@ -246,14 +165,6 @@ LISTSEP
 	: ','
 	;
 
-DECL 
-	: '-'
-	;
-	
-DEF
-	: ':' ((' ')* '>')? 
-	;
-
 SEVERITY
 	: SFSEVERITY
 	| FSEVERITY	
@@ -278,7 +189,7 @@ FSEVERITY
 	;
 
 ID
-	:  ('a'..'z' | 'A'..'Z' | '0'..'9' | '_') ('a'..'z' | 'A'..'Z' | '0'..'9' | '_' | '-' | '.' | ':'? '[' (NUMBER|PATH) (':' PATH)* ']' | '*' )*	
+	:  ('a'..'z' | 'A'..'Z' | '0'..'9' | '_' | '$') ('a'..'z' | 'A'..'Z' | '0'..'9' | '_' | '-' | '.' | ':'? '[' (NUMBER|PATH) (':' PATH)* ']' | '*' )*	
 	;
 	
 NUMBER 
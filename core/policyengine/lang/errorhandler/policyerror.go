@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errorhandler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyError stores the location and context of an error found
+// while compiling a policy file
+type PolicyError struct {
+	File         string
+	Line, Column int
+	Decl         string
+	Token        string
+	Msg          string
+}
+
+// Error returns a formatted string of the form file:line:column: decl: msg (near token)
+func (e *PolicyError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.File)
+	if e.Line > 0 {
+		fmt.Fprintf(&b, ":%d", e.Line)
+		if e.Column > 0 {
+			fmt.Fprintf(&b, ":%d", e.Column)
+		}
+	}
+	b.WriteString(": ")
+	if e.Decl != "" {
+		b.WriteString(e.Decl)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Msg)
+	if e.Token != "" {
+		fmt.Fprintf(&b, " (near %q)", e.Token)
+	}
+	return b.String()
+}
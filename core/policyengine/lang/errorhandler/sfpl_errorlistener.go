@@ -10,6 +10,7 @@ import (
 // policy parsing
 type SfplSyntaxError struct {
 	line, column int
+	token        string
 	msg          string
 }
 
@@ -18,6 +19,26 @@ func (s *SfplSyntaxError) Error() string {
 	return fmt.Sprintf("line: %d  column: %d %s", s.line, s.column, s.msg)
 }
 
+// Line returns the line (1-based) of the syntax error
+func (s *SfplSyntaxError) Line() int {
+	return s.line
+}
+
+// Column returns the column (0-based) of the syntax error
+func (s *SfplSyntaxError) Column() int {
+	return s.column
+}
+
+// Token returns the text of the offending token, if known
+func (s *SfplSyntaxError) Token() string {
+	return s.token
+}
+
+// Msg returns the error message reported by the recognizer
+func (s *SfplSyntaxError) Msg() string {
+	return s.msg
+}
+
 // SfplErrorListener monitors errors during the policy parsing process
 // and stores them in an error list
 type SfplErrorListener struct {
@@ -27,9 +48,14 @@ type SfplErrorListener struct {
 
 // SyntaxError is called by the antlr lexer and parser when it encounters and error
 func (l *SfplErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	var token string
+	if t, ok := offendingSymbol.(antlr.Token); ok && t.GetTokenType() != antlr.TokenEOF {
+		token = t.GetText()
+	}
 	l.Errors = append(l.Errors, &SfplSyntaxError{
 		line:   line,
 		column: column,
+		token:  token,
 		msg:    msg,
 	})
 }
@@ -7,36 +7,6 @@ import "github.com/antlr/antlr4/runtime/Go/antlr"
 type SfplVisitor interface {
 	antlr.ParseTreeVisitor
 
-	// Visit a parse tree produced by SfplParser#policy.
-	VisitPolicy(ctx *PolicyContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#defs.
-	VisitDefs(ctx *DefsContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#prule.
-	VisitPrule(ctx *PruleContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#srule.
-	VisitSrule(ctx *SruleContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#pfilter.
-	VisitPfilter(ctx *PfilterContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#sfilter.
-	VisitSfilter(ctx *SfilterContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#drop_keyword.
-	VisitDrop_keyword(ctx *Drop_keywordContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#pmacro.
-	VisitPmacro(ctx *PmacroContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#plist.
-	VisitPlist(ctx *PlistContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#preq.
-	VisitPreq(ctx *PreqContext) interface{}
-
 	// Visit a parse tree produced by SfplParser#expression.
 	VisitExpression(ctx *ExpressionContext) interface{}
 
@@ -52,42 +22,18 @@ type SfplVisitor interface {
 	// Visit a parse tree produced by SfplParser#items.
 	VisitItems(ctx *ItemsContext) interface{}
 
-	// Visit a parse tree produced by SfplParser#actions.
-	VisitActions(ctx *ActionsContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#tags.
-	VisitTags(ctx *TagsContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#prefilter.
-	VisitPrefilter(ctx *PrefilterContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#severity.
-	VisitSeverity(ctx *SeverityContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#enabled.
-	VisitEnabled(ctx *EnabledContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#warnevttype.
-	VisitWarnevttype(ctx *WarnevttypeContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#skipunknown.
-	VisitSkipunknown(ctx *SkipunknownContext) interface{}
-
-	// Visit a parse tree produced by SfplParser#fappend.
-	VisitFappend(ctx *FappendContext) interface{}
-
 	// Visit a parse tree produced by SfplParser#variable.
 	VisitVariable(ctx *VariableContext) interface{}
 
 	// Visit a parse tree produced by SfplParser#atom.
 	VisitAtom(ctx *AtomContext) interface{}
 
-	// Visit a parse tree produced by SfplParser#text.
-	VisitText(ctx *TextContext) interface{}
-
 	// Visit a parse tree produced by SfplParser#binary_operator.
 	VisitBinary_operator(ctx *Binary_operatorContext) interface{}
 
 	// Visit a parse tree produced by SfplParser#unary_operator.
 	VisitUnary_operator(ctx *Unary_operatorContext) interface{}
+
+	// Visit a parse tree produced by SfplParser#macro_call.
+	VisitMacro_call(ctx *Macro_callContext) interface{}
 }
@@ -23,6 +23,8 @@ package policyengine
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -44,8 +46,16 @@ const (
 type PolicyEngine struct {
 	pi            *engine.PolicyInterpreter
 	outCh         []chan *engine.Record
+	alertCh       []chan *engine.Record
+	routeCh       map[string]chan *engine.Record
+	defaultCh     []chan *engine.Record
 	config        engine.Config
 	policyMonitor monitor.PolicyMonitor
+	shadow        *engine.PolicyInterpreter
+	shadowMonitor monitor.PolicyMonitor
+	activeTally   *engine.RuleTally
+	shadowTally   *engine.RuleTally
+	shadowDone    chan struct{}
 }
 
 // NewPolicyEngine constructs a new Policy Engine plugin.
@@ -71,13 +81,23 @@ func (s *PolicyEngine) Register(pc plugins.SFPluginCache) {
 
 // Init initializes the plugin.
 func (s *PolicyEngine) Init(conf map[string]interface{}) (err error) {
-	s.config, _ = engine.CreateConfig(conf) // no err check, assuming defaults
+	if s.config, err = engine.CreateConfig(conf); err != nil {
+		return
+	}
 
 	if s.config.Mode == engine.EnrichMode {
 		logger.Trace.Println("Setting policy engine in 'enrich' mode")
 		if s.config.PoliciesPath == sfgo.Zeros.String {
 			return
 		}
+	} else if s.config.Mode == engine.DualMode {
+		logger.Trace.Println("Setting policy engine in 'dual' mode")
+		if s.config.PoliciesPath == sfgo.Zeros.String {
+			return errors.New("configuration attribute 'policies' missing from policy engine plugin settings")
+		}
+		if !s.hasOutChannel(s.config.AlertChannel) {
+			return fmt.Errorf("configuration attribute 'alert.channel' must name one of the policy engine's output channels, got '%s'", s.config.AlertChannel)
+		}
 	} else {
 		logger.Trace.Println("Setting policy engine in 'alert' mode")
 		if s.config.PoliciesPath == sfgo.Zeros.String {
@@ -85,8 +105,14 @@ func (s *PolicyEngine) Init(conf map[string]interface{}) (err error) {
 		}
 	}
 
+	for _, rt := range s.config.Routes {
+		if !s.hasOutChannel(rt.Channel) || (s.config.Mode == engine.DualMode && rt.Channel == s.config.AlertChannel) {
+			return fmt.Errorf("route 'route.%s' must name one of the policy engine's output channels", rt.Channel)
+		}
+	}
+
 	if s.config.Monitor == engine.NoneType {
-		s.pi, err = s.createPolicyInterpreter()
+		s.pi, err = s.createPolicyInterpreter(s.config.PoliciesPath, s.config, s.out)
 		if err != nil {
 			logger.Error.Printf("Unable to compile local policies from directory %s, %v", s.config.PoliciesPath, err)
 			return
@@ -107,6 +133,34 @@ func (s *PolicyEngine) Init(conf map[string]interface{}) (err error) {
 		}
 		s.policyMonitor.StartMonitor()
 	}
+
+	if s.config.ShadowPath != sfgo.Zeros.String {
+		conf := s.config.ShadowConfig()
+		s.shadowTally = engine.NewRuleTally()
+		if s.config.Monitor == engine.NoneType {
+			s.shadow, err = s.createPolicyInterpreter(s.config.ShadowPath, conf, s.shadowTally.Add)
+			if err != nil {
+				logger.Error.Printf("Unable to compile shadow policies from directory %s, %v", s.config.ShadowPath, err)
+				return
+			}
+		} else {
+			s.shadowMonitor, err = monitor.NewPolicyMonitor(conf, s.shadowTally.Add)
+			if err != nil {
+				logger.Error.Printf("Unable to load shadow policy monitor %s, %v", s.config.Monitor.String(), err)
+				return
+			}
+			select {
+			case s.shadow = <-s.shadowMonitor.GetInterpreterChan():
+				logger.Info.Printf("Loaded shadow policies from policy monitor %s.", s.config.Monitor.String())
+				s.shadow.StartWorkers()
+			default:
+				return errors.New("no shadow policy interpreter available for plugin")
+			}
+			s.shadowMonitor.StartMonitor()
+		}
+		s.activeTally = engine.NewRuleTally()
+		s.startShadowReports()
+	}
 	return
 }
 
@@ -144,21 +198,54 @@ func (s *PolicyEngine) Process(ch []interface{}, wg *sync.WaitGroup) {
 						s.pi = pi
 					default:
 					}
+					if s.shadowMonitor != nil {
+						select {
+						case pi := <-s.shadowMonitor.GetInterpreterChan():
+							logger.Info.Println("Updated shadow policy interpreter in main policy engine thread.")
+							s.shadow.StopWorkers()
+							pi.StartWorkers()
+							s.shadow = pi
+						default:
+						}
+					}
 					expiration = now.Add(s.config.MonitorInterval)
 				}
 			}
 			// Process record in interpreter's worker pool
 			s.pi.ProcessAsync(engine.NewRecord(*fc))
+			if s.shadow != nil {
+				s.shadow.ProcessAsync(engine.NewRecord(*fc))
+			}
 		} else {
 			logger.Trace.Println("Input channel closed. Shutting down.")
 			break
 		}
 	}
+
+	// Report coverage once all records have been evaluated
+	if s.pi != nil && s.pi.Coverage() != nil {
+		s.pi.StopWorkers()
+		s.writeCoverage()
+	}
+}
+
+// Writes the policy coverage report to the path set in the configuration.
+func (s *PolicyEngine) writeCoverage() {
+	f, err := os.Create(s.config.Coverage)
+	if err != nil {
+		logger.Error.Println("Unable to create policy coverage report: ", err)
+		return
+	}
+	defer f.Close()
+	if err := s.pi.Coverage().WriteReport(f); err != nil {
+		logger.Error.Println("Unable to write policy coverage report: ", err)
+		return
+	}
+	logger.Info.Println("Wrote policy coverage report to ", s.config.Coverage)
 }
 
-// Creates a policy interpreter from configuration.
-func (s *PolicyEngine) createPolicyInterpreter() (*engine.PolicyInterpreter, error) {
-	dir := s.config.PoliciesPath
+// Creates a policy interpreter for the policies in dir.
+func (s *PolicyEngine) createPolicyInterpreter(dir string, conf engine.Config, out func(*engine.Record)) (*engine.PolicyInterpreter, error) {
 	logger.Info.Println("Loading policies from: ", dir)
 	paths, err := ioutils.ListFilePaths(dir, ".yaml")
 	if err != nil {
@@ -168,7 +255,7 @@ func (s *PolicyEngine) createPolicyInterpreter() (*engine.PolicyInterpreter, err
 		return nil, errors.New("no policy files with extension .yaml found in path: " + dir)
 	}
 	logger.Info.Println("Creating policy interpreter")
-	pi := engine.NewPolicyInterpreter(s.config, s.out)
+	pi := engine.NewPolicyInterpreter(conf, out)
 	err = pi.Compile(paths...)
 	if err != nil {
 		return nil, err
@@ -177,17 +264,107 @@ func (s *PolicyEngine) createPolicyInterpreter() (*engine.PolicyInterpreter, err
 	return pi, nil
 }
 
-// out sends a record to every output channel in the plugin.
+// out sends a record to every output channel in the plugin, or to the channels selected by the routes if routes are configured.
+// In dual mode, records matching a rule are additionally sent as alerts to the alert channel.
 func (s *PolicyEngine) out(r *engine.Record) {
-	for _, c := range s.outCh {
-		c <- r
+	if s.activeTally != nil {
+		s.activeTally.Add(r)
 	}
+	// the alert copy is made before r is handed downstream, where its context may be read or changed
+	var a *engine.Record
+	if len(s.alertCh) > 0 && len(r.Ctx.GetRules()) > 0 {
+		a = engine.NewAlert(r)
+	}
+	if len(s.config.Routes) > 0 {
+		s.route(r)
+	} else {
+		for _, c := range s.outCh {
+			c <- r
+		}
+	}
+	if a != nil {
+		for _, c := range s.alertCh {
+			c <- a
+		}
+	}
+}
+
+// route sends a record to the output channels of all matching routes.
+// Records not matching any route are sent to the output channels without routes.
+func (s *PolicyEngine) route(r *engine.Record) {
+	routed := false
+	for _, rt := range s.config.Routes {
+		if c, ok := s.routeCh[rt.Channel]; ok && rt.Matches(r) {
+			c <- r
+			routed = true
+		}
+	}
+	if !routed {
+		for _, c := range s.defaultCh {
+			c <- r
+		}
+	}
+}
+
+// startShadowReports periodically logs the differences between the matches of the active and shadow policies.
+func (s *PolicyEngine) startShadowReports() {
+	s.shadowDone = make(chan struct{})
+	ticker := time.NewTicker(s.config.ShadowInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.reportShadow()
+			case <-s.shadowDone:
+				return
+			}
+		}
+	}()
+}
+
+// reportShadow logs the differences between the matches of the active and shadow policies since the last report.
+func (s *PolicyEngine) reportShadow() {
+	d := engine.DiffRuleCounts(s.activeTally.Reset(), s.shadowTally.Reset())
+	if d.Active == 0 && d.Shadow == 0 {
+		return
+	}
+	logger.Info.Printf("Shadow policies %s: %s", s.config.ShadowPath, d.String())
+}
+
+// hasOutChannel checks whether name identifies one of the output channels of the plugin.
+func (s *PolicyEngine) hasOutChannel(name string) bool {
+	for _, n := range s.config.OutChannels {
+		if n == name {
+			return true
+		}
+	}
+	return false
 }
 
 // SetOutChan sets the output channel of the plugin.
 func (s *PolicyEngine) SetOutChan(ch []interface{}) {
-	for _, c := range ch {
-		s.outCh = append(s.outCh, (c.(*engine.RecordChannel)).In)
+	routed := make(map[string]bool)
+	for _, rt := range s.config.Routes {
+		routed[rt.Channel] = true
+	}
+	s.routeCh = make(map[string]chan *engine.Record)
+	for i, c := range ch {
+		in := (c.(*engine.RecordChannel)).In
+		var name string
+		if i < len(s.config.OutChannels) {
+			name = s.config.OutChannels[i]
+		}
+		if s.config.Mode == engine.DualMode && name == s.config.AlertChannel {
+			s.alertCh = append(s.alertCh, in)
+			continue
+		}
+		s.outCh = append(s.outCh, in)
+		if routed[name] {
+			s.routeCh[name] = in
+		} else {
+			s.defaultCh = append(s.defaultCh, in)
+		}
 	}
 }
 
@@ -197,12 +374,25 @@ func (s *PolicyEngine) Cleanup() {
 	if s.pi != nil {
 		s.pi.StopWorkers()
 	}
+	if s.shadow != nil {
+		s.shadow.StopWorkers()
+		close(s.shadowDone)
+		s.reportShadow()
+	}
 	if s.outCh != nil {
 		for _, c := range s.outCh {
 			close(c)
 		}
 	}
+	if s.alertCh != nil {
+		for _, c := range s.alertCh {
+			close(c)
+		}
+	}
 	if s.policyMonitor != nil {
 		s.policyMonitor.StopMonitor()
 	}
+	if s.shadowMonitor != nil {
+		s.shadowMonitor.StopMonitor()
+	}
 }
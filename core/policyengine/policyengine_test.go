@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package policyengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
+)
+
+const testPolicies = "../../resources/policies/tests"
+
+func TestInitConfigErrors(t *testing.T) {
+	for _, tc := range []struct {
+		key   string
+		value string
+	}{
+		{engine.ExplainKey, "maybe"},
+		{engine.ShadowIntervalKey, "0"},
+		{engine.ShadowIntervalKey, "-1"},
+	} {
+		s := NewPolicyEngine().(*PolicyEngine)
+		err := s.Init(map[string]interface{}{engine.PoliciesConfigKey: testPolicies, tc.key: tc.value})
+		if assert.Error(t, err, "%s: %s", tc.key, tc.value) {
+			assert.Contains(t, err.Error(), tc.key)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processor implements a processor plugin.
+package processor
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-processor/core/cache"
+)
+
+// Configuration keys.
+const (
+	FileCacheSizeKey   string = "cache.file.maxsize"
+	FileCacheTTLKey    string = "cache.file.ttl"
+	ContCacheTTLKey    string = "cache.container.ttl"
+	StatsIntervalKey   string = "cache.stats.interval"
+	HoldbackSizeKey    string = "cache.holdback.size"
+	HoldbackTimeoutKey string = "cache.holdback.timeout"
+	SourceTTLKey       string = "cache.source.ttl"
+	SnapshotPathKey    string = "cache.snapshot.path"
+	SnapshotIntKey     string = "cache.snapshot.interval"
+	SnapshotMaxAgeKey  string = "cache.snapshot.maxage"
+	DumpDirKey         string = "cache.dump.dir"
+)
+
+// ReaderConfig defines a configuration object for the SysFlow reader.
+type ReaderConfig struct {
+	Tables          cache.Config
+	StatsInterval   time.Duration
+	HoldbackSize    int
+	HoldbackTimeout time.Duration
+	SourceTTL       time.Duration
+	SnapshotPath    string
+	SnapshotInt     time.Duration
+	SnapshotMaxAge  time.Duration
+	DumpDir         string
+}
+
+// CreateReaderConfig creates a new reader config object from config dictionary.
+func CreateReaderConfig(conf map[string]interface{}) (ReaderConfig, error) {
+	var c ReaderConfig = ReaderConfig{HoldbackTimeout: time.Second, SourceTTL: time.Hour, SnapshotInt: time.Minute, SnapshotMaxAge: 5 * time.Minute, DumpDir: os.TempDir()} // default values
+	var err error
+	if v, ok := conf[FileCacheSizeKey].(string); ok {
+		c.Tables.FileMaxSize, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+	}
+	if v, ok := conf[FileCacheTTLKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.Tables.FileTTL = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[ContCacheTTLKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.Tables.ContTTL = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[StatsIntervalKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.StatsInterval = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[HoldbackSizeKey].(string); ok {
+		c.HoldbackSize, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+	}
+	if v, ok := conf[HoldbackTimeoutKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.HoldbackTimeout = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[SourceTTLKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.SourceTTL = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[SnapshotPathKey].(string); ok {
+		c.SnapshotPath = v
+	}
+	if v, ok := conf[SnapshotIntKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.SnapshotInt = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[SnapshotMaxAgeKey].(string); ok {
+		var duration int
+		duration, err = strconv.Atoi(v)
+		if err != nil {
+			return c, err
+		}
+		c.SnapshotMaxAge = time.Duration(duration) * time.Second
+	}
+	if v, ok := conf[DumpDirKey].(string); ok {
+		c.DumpDir = v
+	}
+	return c, nil
+}
@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processor implements a processor plugin.
+package processor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+const (
+	cHandlersName string = "handlers"
+	cOutName      string = "out"
+)
+
+// fanout dispatches each record to a list of handlers, each of which writes to its own
+// subset of the processor's output channels. Entity records are only dispatched to the
+// handlers that request them.
+type fanout struct {
+	hdls       []plugins.SFHandler
+	entEnabled []bool
+	outIDs     [][]string
+	chanIDs    []string
+}
+
+// newFanout creates the handlers of a "handlers" list. The configuration of each handler is
+// the processor's configuration overlaid with the attributes of its list entry, whose "out"
+// attribute selects, by identifier, the processor output channels the handler writes to.
+// Since handlers close their output channels on cleanup, each output channel of the processor
+// must be assigned to exactly one handler.
+func newFanout(hc *HandlerCache, conf map[string]interface{}, entries []interface{}) (*fanout, error) {
+	f := &fanout{chanIDs: chanIDs(conf[cOutName])}
+	owners := make(map[string]interface{}, len(f.chanIDs))
+	for i, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("entry %d of attribute '%s' must be an object", i, cHandlersName)
+		}
+		hconf := make(map[string]interface{}, len(conf)+len(entry))
+		for k, v := range conf {
+			if k != cHandlersName && k != cOutName {
+				hconf[k] = v
+			}
+		}
+		for k, v := range entry {
+			hconf[k] = v
+		}
+		hdl, err := hc.GetHandler(hconf)
+		if err != nil {
+			return nil, err
+		}
+		if err = hdl.Init(hconf); err != nil {
+			return nil, err
+		}
+		outIDs := chanIDs(entry[cOutName])
+		for _, id := range outIDs {
+			if !contains(f.chanIDs, id) {
+				return nil, fmt.Errorf("handler '%v' writes to channel '%s', which is not an output channel of the processor", hconf[cHandlerName], id)
+			}
+			if owner, ok := owners[id]; ok {
+				return nil, fmt.Errorf("handlers '%v' and '%v' both write to channel '%s'", owner, hconf[cHandlerName], id)
+			}
+			owners[id] = hconf[cHandlerName]
+		}
+		f.hdls = append(f.hdls, hdl)
+		f.entEnabled = append(f.entEnabled, hdl.IsEntityEnabled())
+		f.outIDs = append(f.outIDs, outIDs)
+	}
+	for _, id := range f.chanIDs {
+		if _, ok := owners[id]; !ok {
+			return nil, fmt.Errorf("output channel '%s' of the processor is not assigned to any handler", id)
+		}
+	}
+	return f, nil
+}
+
+// chanIDs returns the identifiers of a channel list attribute, given either as a string or as
+// a list of strings, each of the form "<id> <type>" or "<id>".
+func chanIDs(v interface{}) (ids []string) {
+	var chans []string
+	switch t := v.(type) {
+	case string:
+		chans = append(chans, t)
+	case []interface{}:
+		for _, c := range t {
+			if s, ok := c.(string); ok {
+				chans = append(chans, s)
+			}
+		}
+	}
+	for _, c := range chans {
+		if fields := strings.Fields(c); len(fields) > 0 {
+			ids = append(ids, fields[0])
+		}
+	}
+	return
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterChannel is a no-op, as the handlers are registered by the handler cache.
+func (f *fanout) RegisterChannel(pc plugins.SFPluginCache) {}
+
+// RegisterHandler is a no-op, as the handlers are registered by the handler cache.
+func (f *fanout) RegisterHandler(hc plugins.SFHandlerCache) {}
+
+// Init is a no-op, as the handlers are initialized on creation.
+func (f *fanout) Init(conf map[string]interface{}) error {
+	return nil
+}
+
+// IsEntityEnabled checks if any handler handles entity records.
+func (f *fanout) IsEntityEnabled() bool {
+	for _, e := range f.entEnabled {
+		if e {
+			return true
+		}
+	}
+	return false
+}
+
+// SetOutChan passes each handler the output channels it writes to.
+func (f *fanout) SetOutChan(ch []interface{}) {
+	for i, hdl := range f.hdls {
+		var hch []interface{}
+		for j, id := range f.chanIDs {
+			if j < len(ch) && contains(f.outIDs[i], id) {
+				hch = append(hch, ch[j])
+			}
+		}
+		hdl.SetOutChan(hch)
+	}
+}
+
+// Cleanup tears down the handlers.
+func (f *fanout) Cleanup() {
+	for _, hdl := range f.hdls {
+		hdl.Cleanup()
+	}
+}
+
+// dispatch calls a handler method on all handlers, or on the entity-enabled ones only,
+// and returns the last error.
+func (f *fanout) dispatch(entity bool, handle func(hdl plugins.SFHandler) error) (err error) {
+	for i, hdl := range f.hdls {
+		if entity && !f.entEnabled[i] {
+			continue
+		}
+		if e := handle(hdl); e != nil {
+			err = e
+		}
+	}
+	return
+}
+
+// HandleHeader processes Header entities.
+func (f *fanout) HandleHeader(sf *plugins.CtxSysFlow, hdr *sfgo.SFHeader) error {
+	return f.dispatch(true, func(hdl plugins.SFHandler) error { return hdl.HandleHeader(sf, hdr) })
+}
+
+// HandleContainer processes Container entities.
+func (f *fanout) HandleContainer(sf *plugins.CtxSysFlow, cont *sfgo.Container) error {
+	return f.dispatch(true, func(hdl plugins.SFHandler) error { return hdl.HandleContainer(sf, cont) })
+}
+
+// HandlePod processes Pod entities.
+func (f *fanout) HandlePod(sf *plugins.CtxSysFlow, pod *sfgo.Pod) error {
+	return f.dispatch(true, func(hdl plugins.SFHandler) error { return hdl.HandlePod(sf, pod) })
+}
+
+// HandleK8sEvt processes K8s Events.
+func (f *fanout) HandleK8sEvt(sf *plugins.CtxSysFlow, ke *sfgo.K8sEvent) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleK8sEvt(sf, ke) })
+}
+
+// HandleProcess processes Process entities.
+func (f *fanout) HandleProcess(sf *plugins.CtxSysFlow, proc *sfgo.Process) error {
+	return f.dispatch(true, func(hdl plugins.SFHandler) error { return hdl.HandleProcess(sf, proc) })
+}
+
+// HandleFile processes File entities.
+func (f *fanout) HandleFile(sf *plugins.CtxSysFlow, file *sfgo.File) error {
+	return f.dispatch(true, func(hdl plugins.SFHandler) error { return hdl.HandleFile(sf, file) })
+}
+
+// HandleNetFlow processes Network Flows.
+func (f *fanout) HandleNetFlow(sf *plugins.CtxSysFlow, nf *sfgo.NetworkFlow) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleNetFlow(sf, nf) })
+}
+
+// HandleNetEvt processes Network Events.
+func (f *fanout) HandleNetEvt(sf *plugins.CtxSysFlow, ne *sfgo.NetworkEvent) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleNetEvt(sf, ne) })
+}
+
+// HandleFileFlow processes File Flows.
+func (f *fanout) HandleFileFlow(sf *plugins.CtxSysFlow, ff *sfgo.FileFlow) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleFileFlow(sf, ff) })
+}
+
+// HandleFileEvt processes File Events.
+func (f *fanout) HandleFileEvt(sf *plugins.CtxSysFlow, fe *sfgo.FileEvent) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleFileEvt(sf, fe) })
+}
+
+// HandleProcFlow processes Process Flows.
+func (f *fanout) HandleProcFlow(sf *plugins.CtxSysFlow, pf *sfgo.ProcessFlow) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleProcFlow(sf, pf) })
+}
+
+// HandleProcEvt processes Process Events.
+func (f *fanout) HandleProcEvt(sf *plugins.CtxSysFlow, pe *sfgo.ProcessEvent) error {
+	return f.dispatch(false, func(hdl plugins.SFHandler) error { return hdl.HandleProcEvt(sf, pe) })
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/flattener"
+	"github.com/sysflow-telemetry/sf-processor/core/graphlet"
+)
+
+type pluginCache struct{}
+
+func (pc *pluginCache) AddDriver(name string, factory interface{})    {}
+func (pc *pluginCache) AddProcessor(name string, factory interface{}) {}
+func (pc *pluginCache) AddChannel(name string, factory interface{})   {}
+
+func TestFanout(t *testing.T) {
+	hc := newHandlerCache(&pluginCache{})
+	conf := map[string]interface{}{
+		"out": []interface{}{"flat flattenerchan", "graphlets graphletchan"},
+		"handlers": []interface{}{
+			map[string]interface{}{"handler": "flattener", "out": "flat"},
+			map[string]interface{}{"handler": "graphlet", "out": []interface{}{"graphlets graphletchan"}},
+		},
+	}
+	f, err := newFanout(hc, conf, conf["handlers"].([]interface{}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	flat := flattener.NewFlattenerChan(10).(*flattener.FlatChannel)
+	graphlets := graphlet.NewGraphletChan(10).(*graphlet.Channel)
+	f.SetOutChan([]interface{}{flat, graphlets})
+	assert.False(t, f.IsEntityEnabled())
+
+	proc := &sfgo.Process{Oid: &sfgo.OID{Hpid: 1}, Exe: "/bin/sh"}
+	sf := &plugins.CtxSysFlow{
+		SysFlow: &sfgo.SysFlow{Rec: &sfgo.RecUnion{UnionType: sfgo.SF_PROC_EVT}},
+		Header:  &sfgo.SFHeader{Exporter: "node1"},
+		Process: proc,
+		PTree:   []*sfgo.Process{proc},
+	}
+	assert.NoError(t, f.HandleProcEvt(sf, &sfgo.ProcessEvent{ProcOID: proc.Oid, Tid: 1, OpFlags: sfgo.OP_EXEC}))
+	assert.Len(t, flat.In, 1)
+	f.Cleanup()
+	if g, ok := <-graphlets.In; assert.True(t, ok) {
+		assert.Equal(t, "/bin/sh", g.Procs[0].Exe)
+	}
+
+	// handlers may only write to the processor's output channels
+	conf["handlers"] = []interface{}{map[string]interface{}{"handler": "flattener", "out": "events"}}
+	_, err = newFanout(hc, conf, conf["handlers"].([]interface{}))
+	assert.Error(t, err)
+
+	// each output channel is written to by exactly one handler
+	conf["handlers"] = []interface{}{
+		map[string]interface{}{"handler": "flattener", "out": "flat"},
+		map[string]interface{}{"handler": "flattener", "out": []interface{}{"flat", "graphlets"}},
+	}
+	_, err = newFanout(hc, conf, conf["handlers"].([]interface{}))
+	assert.Error(t, err)
+	conf["handlers"] = []interface{}{map[string]interface{}{"handler": "flattener", "out": "flat"}}
+	_, err = newFanout(hc, conf, conf["handlers"].([]interface{}))
+	assert.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
 	"github.com/sysflow-telemetry/sf-processor/core/flattener"
+	"github.com/sysflow-telemetry/sf-processor/core/graphlet"
 )
 
 var sHCInstance *HandlerCache
@@ -68,6 +69,9 @@ func (p *HandlerCache) init() {
 	flat := &flattener.Flattener{}
 	flat.RegisterHandler(p)
 	flat.RegisterChannel(p.pluginCache)
+	gb := &graphlet.Builder{}
+	gb.RegisterHandler(p)
+	gb.RegisterChannel(p.pluginCache)
 }
 
 // LoadHandler loads dynamic handlers to handler cache from dir path.
@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processor implements a processor plugin.
+package processor
+
+import (
+	"container/list"
+	"fmt"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/cache"
+)
+
+// entityKey identifies the process (by OID) or file (by FOID) entity a parked record waits for.
+type entityKey struct {
+	oid  sfgo.OID
+	foid sfgo.FOID
+	file bool
+}
+
+func procKey(oid sfgo.OID) entityKey {
+	return entityKey{oid: oid}
+}
+
+func fileKey(foid sfgo.FOID) entityKey {
+	return entityKey{foid: foid, file: true}
+}
+
+// parked is a record held back until the entities it refers to arrive.
+type parked struct {
+	rec *sfgo.SysFlow
+	hdr *sfgo.SFHeader
+	ts  time.Time
+	key entityKey
+}
+
+// HoldbackStats holds the hold-back counters of a source.
+type HoldbackStats struct {
+	Parked     int    `json:"parked"`
+	Resolved   uint64 `json:"resolved"`
+	Unresolved uint64 `json:"unresolved"`
+}
+
+// String returns a one-line summary of the hold-back counters.
+func (s HoldbackStats) String() string {
+	return fmt.Sprintf("parked=%d resolved=%d unresolved=%d", s.Parked, s.Resolved, s.Unresolved)
+}
+
+// holdback parks flows and events whose process or file entities have not arrived yet,
+// bounded in the number of parked records and the time a record may stay parked (a zero
+// timeout bounds the buffer in size only). Parked records are kept in arrival order, and
+// indexed by the entity they wait for, so that an arriving entity only visits its waiters.
+type holdback struct {
+	maxSize int
+	timeout time.Duration
+	recs    *list.List
+	waiting map[entityKey][]*list.Element
+	stats   HoldbackStats
+}
+
+func newHoldback(maxSize int, timeout time.Duration) *holdback {
+	return &holdback{maxSize: maxSize, timeout: timeout, recs: list.New(), waiting: make(map[entityKey][]*list.Element)}
+}
+
+// park holds back a record waiting for entity k, and returns the oldest parked record if the buffer overflows.
+func (h *holdback) park(rec *sfgo.SysFlow, hdr *sfgo.SFHeader, k entityKey, now time.Time) *parked {
+	h.waiting[k] = append(h.waiting[k], h.recs.PushBack(&parked{rec: rec, hdr: hdr, ts: now, key: k}))
+	if h.maxSize > 0 && h.recs.Len() > h.maxSize {
+		return h.evict(h.recs.Front())
+	}
+	return nil
+}
+
+// release removes and returns, in arrival order, the parked records resolved by the arrival of
+// entity k. Records that still miss another entity are parked again waiting for that entity.
+func (h *holdback) release(k entityKey, tables *cache.SFTables) (recs []*parked) {
+	elems, ok := h.waiting[k]
+	if !ok {
+		return
+	}
+	delete(h.waiting, k)
+	for _, e := range elems {
+		p := e.Value.(*parked)
+		if next, missing := missingEntity(p.rec, tables); missing {
+			p.key = next
+			h.waiting[next] = append(h.waiting[next], e)
+			continue
+		}
+		h.recs.Remove(e)
+		h.stats.Resolved++
+		recs = append(recs, p)
+	}
+	return
+}
+
+// expire removes and returns the records parked for longer than the timeout.
+func (h *holdback) expire(now time.Time) (recs []*parked) {
+	if h.timeout <= 0 {
+		return
+	}
+	for e := h.recs.Front(); e != nil && now.Sub(e.Value.(*parked).ts) > h.timeout; e = h.recs.Front() {
+		recs = append(recs, h.evict(e))
+	}
+	return
+}
+
+// flush removes and returns all parked records.
+func (h *holdback) flush() (recs []*parked) {
+	for e := h.recs.Front(); e != nil; e = h.recs.Front() {
+		h.stats.Unresolved++
+		recs = append(recs, h.recs.Remove(e).(*parked))
+	}
+	h.waiting = make(map[entityKey][]*list.Element)
+	return
+}
+
+// evict removes parked record e unresolved.
+func (h *holdback) evict(e *list.Element) *parked {
+	p := h.recs.Remove(e).(*parked)
+	elems := h.waiting[p.key]
+	for i := range elems {
+		if elems[i] == e {
+			elems = append(elems[:i], elems[i+1:]...)
+			break
+		}
+	}
+	if len(elems) == 0 {
+		delete(h.waiting, p.key)
+	} else {
+		h.waiting[p.key] = elems
+	}
+	h.stats.Unresolved++
+	return p
+}
+
+// Stats returns the current hold-back counters.
+func (h *holdback) Stats() HoldbackStats {
+	s := h.stats
+	s.Parked = h.recs.Len()
+	return s
+}
+
+// String returns a one-line summary of the hold-back counters.
+func (h *holdback) String() string {
+	return h.Stats().String()
+}
+
+// missingEntity returns the first process or file entity referenced by a record that is not cached yet.
+// Records without a process OID do not wait for any entity.
+func missingEntity(rec *sfgo.SysFlow, tables *cache.SFTables) (entityKey, bool) {
+	var proc *sfgo.OID
+	var files []sfgo.FOID
+	switch rec.Rec.UnionType {
+	case sfgo.SF_PROC_EVT:
+		proc = rec.Rec.ProcessEvent.ProcOID
+	case sfgo.SF_NET_FLOW:
+		proc = rec.Rec.NetworkFlow.ProcOID
+	case sfgo.SF_PROC_FLOW:
+		proc = rec.Rec.ProcessFlow.ProcOID
+	case sfgo.SF_NET_EVT:
+		proc = rec.Rec.NetworkEvent.ProcOID
+	case sfgo.SF_FILE_FLOW:
+		proc, files = rec.Rec.FileFlow.ProcOID, []sfgo.FOID{rec.Rec.FileFlow.FileOID}
+	case sfgo.SF_FILE_EVT:
+		fe := rec.Rec.FileEvent
+		proc, files = fe.ProcOID, []sfgo.FOID{fe.FileOID}
+		if fe.NewFileOID != nil && fe.NewFileOID.UnionType == sfgo.NewFileOIDUnionTypeEnumFOID {
+			files = append(files, fe.NewFileOID.FOID)
+		}
+	default:
+		return entityKey{}, false
+	}
+	if proc == nil {
+		return entityKey{}, false
+	}
+	if !tables.HasProc(*proc) {
+		return procKey(*proc), true
+	}
+	for _, foid := range files {
+		if !tables.HasFile(foid) {
+			return fileKey(foid), true
+		}
+	}
+	return entityKey{}, false
+}
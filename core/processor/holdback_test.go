@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package processor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/cache"
+)
+
+func procEvt(pid int64) *sfgo.SysFlow {
+	return &sfgo.SysFlow{Rec: &sfgo.RecUnion{UnionType: sfgo.SF_PROC_EVT, ProcessEvent: &sfgo.ProcessEvent{ProcOID: &sfgo.OID{Hpid: pid}, Tid: pid}}}
+}
+
+func fileFlow(pid int64, foid sfgo.FOID) *sfgo.SysFlow {
+	return &sfgo.SysFlow{Rec: &sfgo.RecUnion{UnionType: sfgo.SF_FILE_FLOW, FileFlow: &sfgo.FileFlow{ProcOID: &sfgo.OID{Hpid: pid}, FileOID: foid, Tid: pid}}}
+}
+
+func park(h *holdback, rec *sfgo.SysFlow, tables *cache.SFTables, now time.Time) *parked {
+	k, missing := missingEntity(rec, tables)
+	if !missing {
+		panic("record is resolved")
+	}
+	return h.park(rec, nil, k, now)
+}
+
+func TestHoldback(t *testing.T) {
+	tables := cache.NewSFTables()
+	h := newHoldback(2, time.Second)
+	now := time.Unix(0, 0)
+
+	assert.Nil(t, park(h, procEvt(1), tables, now))
+	assert.Nil(t, park(h, procEvt(2), tables, now.Add(time.Millisecond)))
+	assert.Empty(t, h.release(procKey(sfgo.OID{Hpid: 3}), tables))
+
+	tables.SetProc(sfgo.OID{Hpid: 2}, &sfgo.Process{Oid: &sfgo.OID{Hpid: 2}})
+	if recs := h.release(procKey(sfgo.OID{Hpid: 2}), tables); assert.Len(t, recs, 1) {
+		assert.Equal(t, int64(2), recs[0].rec.Rec.ProcessEvent.Tid)
+	}
+
+	// overflow emits the oldest record unresolved
+	assert.Nil(t, park(h, procEvt(3), tables, now.Add(2*time.Millisecond)))
+	if p := park(h, procEvt(4), tables, now.Add(3*time.Millisecond)); assert.NotNil(t, p) {
+		assert.Equal(t, int64(1), p.rec.Rec.ProcessEvent.Tid)
+	}
+	assert.NotContains(t, h.waiting, procKey(sfgo.OID{Hpid: 1}))
+
+	// timeout emits expired records unresolved
+	assert.Len(t, h.expire(now.Add(time.Second+2*time.Millisecond)), 0)
+	assert.Len(t, h.expire(now.Add(time.Second+5*time.Millisecond)), 2)
+	assert.Empty(t, h.waiting)
+	assert.Equal(t, HoldbackStats{Parked: 0, Resolved: 1, Unresolved: 3}, h.Stats())
+	assert.Equal(t, "parked=0 resolved=1 unresolved=3", h.String())
+}
+
+func TestHoldbackReindex(t *testing.T) {
+	tables := cache.NewSFTables()
+	h := newHoldback(10, 0)
+	now := time.Unix(0, 0)
+	foid := sfgo.FOID{1}
+
+	// a file flow waits for its process first, then for its file
+	assert.Nil(t, park(h, fileFlow(1, foid), tables, now))
+	assert.Nil(t, park(h, procEvt(1), tables, now))
+	tables.SetProc(sfgo.OID{Hpid: 1}, &sfgo.Process{Oid: &sfgo.OID{Hpid: 1}})
+	if recs := h.release(procKey(sfgo.OID{Hpid: 1}), tables); assert.Len(t, recs, 1) {
+		assert.Equal(t, sfgo.SF_PROC_EVT, recs[0].rec.Rec.UnionType)
+	}
+	assert.Contains(t, h.waiting, fileKey(foid))
+
+	tables.SetFile(foid, &sfgo.File{Oid: foid})
+	if recs := h.release(fileKey(foid), tables); assert.Len(t, recs, 1) {
+		assert.Equal(t, sfgo.SF_FILE_FLOW, recs[0].rec.Rec.UnionType)
+	}
+	assert.Empty(t, h.waiting)
+	assert.Equal(t, HoldbackStats{Parked: 0, Resolved: 2, Unresolved: 0}, h.Stats())
+}
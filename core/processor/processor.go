@@ -72,6 +72,12 @@ func (s *SysFlowProcessor) Register(pc plugins.SFPluginCache) {
 // Init initializes the processor with a configuration map.
 func (s *SysFlowProcessor) Init(conf map[string]interface{}) (err error) {
 	hdlCache := GetHandlerCacheInstance(sPluginCache)
+	if entries, ok := conf[cHandlersName].([]interface{}); ok {
+		if s.hdl, err = newFanout(hdlCache, conf, entries); err != nil {
+			return errors.Wrap(err, "couldn't create the processor handlers")
+		}
+		return nil
+	}
 	s.hdl, err = hdlCache.GetHandler(conf)
 	if err != nil {
 		return errors.Wrap(err, "couldn't obtain the processor handler from cache")
@@ -142,7 +148,11 @@ func (s *SysFlowProcessor) Process(ch []interface{}, wg *sync.WaitGroup) {
 			fe := sf.Rec.FileEvent
 			s.hdl.HandleFileEvt(sf, fe)
 		case sfgo.SF_PROC_FLOW:
+			pf := sf.Rec.ProcessFlow
+			s.hdl.HandleProcFlow(sf, pf)
 		case sfgo.SF_NET_EVT:
+			ne := sf.Rec.NetworkEvent
+			s.hdl.HandleNetEvt(sf, ne)
 		default:
 			logger.Warn.Println("Error unsupported SysFlow Type: ", sf.Rec.UnionType)
 		}
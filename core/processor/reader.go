@@ -22,13 +22,22 @@
 package processor
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"syscall"
+	"time"
 
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
 	"github.com/sysflow-telemetry/sf-processor/core/cache"
+	"github.com/tidwall/gjson"
 )
 
 const (
@@ -40,8 +49,10 @@ const (
 // This plugin should typically be first in the pipeline.
 type SysFlowReader struct {
 	SysFlowProcessor
-	hdr    *sfgo.SFHeader
-	tables *cache.SFTables
+	config  ReaderConfig
+	sources map[string]*source
+	src     *source
+	restore map[string]*cache.Entities
 }
 
 // NewSysFlowProcessor creates a new SysFlowProcessor instance.
@@ -72,7 +83,14 @@ func (s *SysFlowReader) Register(pc plugins.SFPluginCache) {
 
 // Init initializes the processor with a configuration map.
 func (s *SysFlowReader) Init(conf map[string]interface{}) (err error) {
-	s.tables = cache.NewSFTables()
+	if s.config, err = CreateReaderConfig(conf); err != nil {
+		return
+	}
+	s.src = newSource(s.config, time.Now())
+	s.sources = map[string]*source{"": s.src}
+	if s.config.SnapshotPath != "" {
+		s.loadSnapshot(time.Now())
+	}
 	return s.SysFlowProcessor.Init(conf)
 }
 
@@ -87,81 +105,306 @@ func (s *SysFlowReader) Process(ch []interface{}, wg *sync.WaitGroup) {
 	record := cha.In
 	defer wg.Done()
 	logger.Trace.Println("Starting SysFlow Reader...")
+	var tick, expiry, sweep, snapshot <-chan time.Time
+	if s.config.StatsInterval > 0 {
+		ticker := time.NewTicker(s.config.StatsInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	if s.config.HoldbackSize > 0 && s.config.HoldbackTimeout > 0 {
+		ticker := time.NewTicker(s.config.HoldbackTimeout)
+		defer ticker.Stop()
+		expiry = ticker.C
+	}
+	if s.config.SourceTTL > 0 {
+		ticker := time.NewTicker(s.config.SourceTTL)
+		defer ticker.Stop()
+		sweep = ticker.C
+	}
+	if s.config.SnapshotPath != "" && s.config.SnapshotInt > 0 {
+		ticker := time.NewTicker(s.config.SnapshotInt)
+		defer ticker.Stop()
+		snapshot = ticker.C
+	}
+	dump := make(chan os.Signal, 1)
+	signal.Notify(dump, syscall.SIGUSR1)
+	defer signal.Stop(dump)
 	for {
-		r, ok := <-record
-		if !ok {
-			logger.Trace.Println("SysFlow Reader channel closed. Shutting down.")
-			break
-		}
-		sf := new(plugins.CtxSysFlow)
-		sf.SysFlow = r
-		sf.Header = s.hdr
-		switch sf.Rec.UnionType {
-		case sfgo.SF_HEADER:
-			s.hdr = sf.Rec.SFHeader
-			s.tables.Reset()
-			if entEnabled {
-				s.hdl.HandleHeader(sf, s.hdr)
+		select {
+		case r, ok := <-record:
+			if !ok {
+				logger.Trace.Println("SysFlow Reader channel closed. Shutting down.")
+				for _, src := range s.sources {
+					s.flush(src, entEnabled)
+				}
+				if s.config.SnapshotPath != "" {
+					s.saveSnapshot(time.Now())
+				}
+				return
 			}
-		case sfgo.SF_CONT:
-			cont := sf.Rec.Container
-			s.tables.SetCont(cont.Id, cont)
-			if entEnabled {
-				s.hdl.HandleContainer(sf, cont)
+			s.process(r, entEnabled)
+		case <-expiry:
+			now := time.Now()
+			for _, src := range s.sources {
+				s.emit(src.holdback.expire(now), src.tables, entEnabled)
 			}
-		case sfgo.SF_POD:
-			pod := sf.Rec.Pod
-			s.tables.SetPod(pod.Id, pod)
-			if entEnabled {
-				s.hdl.HandlePod(sf, pod)
+		case <-sweep:
+			s.expireSources(time.Now(), entEnabled)
+		case <-snapshot:
+			s.saveSnapshot(time.Now())
+		case <-dump:
+			if path, err := s.dumpTables(time.Now()); err != nil {
+				logger.Error.Println("Unable to dump entity tables: ", err)
+			} else {
+				logger.Info.Printf("Dumped entity tables to %s", path)
 			}
-		case sfgo.SF_K8S_EVT:
-			ke := sf.Rec.K8sEvent
-			s.hdl.HandleK8sEvt(sf, ke)
-		case sfgo.SF_PROCESS:
-			proc := sf.Rec.Process
-			proc.Exe = strings.TrimSpace(proc.Exe)
-			proc.ExeArgs = strings.TrimSpace(proc.ExeArgs)
-			s.tables.SetProc(*proc.Oid, proc)
-			if entEnabled {
-				sf.Process = proc
-				sf.PTree = s.tables.GetPtree(*proc.Oid)
-				sf.Container = s.getContFromProc(proc)
-				sf.Pod = s.getPodFromCont(sf.Container)
-				s.hdl.HandleProcess(sf, proc)
+		case <-tick:
+			ids := make([]string, 0, len(s.sources))
+			for id := range s.sources {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				logger.Info.Printf("Entity tables of source '%s': %s", id, s.sources[id])
+			}
+		}
+	}
+}
+
+// switchSource makes the exporter of a header the current source. The tables of a known
+// exporter are kept, unless the header starts a new trace file.
+func (s *SysFlowReader) switchSource(hdr *sfgo.SFHeader, now time.Time, entEnabled bool) {
+	src, ok := s.sources[hdr.Exporter]
+	switch {
+	case !ok && s.src.hdr == nil:
+		// the records read before the first header belong to its exporter
+		src = s.src
+		delete(s.sources, "")
+		s.sources[hdr.Exporter] = src
+		s.restoreSource(hdr.Exporter, src)
+	case !ok:
+		logger.Info.Printf("New SysFlow source: %s", hdr.Exporter)
+		src = newSource(s.config, now)
+		s.sources[hdr.Exporter] = src
+		s.restoreSource(hdr.Exporter, src)
+	case src.hdr != nil && src.hdr.Filename != hdr.Filename:
+		s.flush(src, entEnabled)
+		src.tables.Reset()
+	}
+	src.hdr = hdr
+	s.src = src
+	s.expireSources(now, entEnabled)
+}
+
+// expireSources drops the sources from which no records have been read for longer than the ttl.
+func (s *SysFlowReader) expireSources(now time.Time, entEnabled bool) {
+	if s.config.SourceTTL <= 0 {
+		return
+	}
+	for id, src := range s.sources {
+		if src != s.src && now.Sub(src.lastSeen) > s.config.SourceTTL {
+			logger.Info.Printf("Expiring idle SysFlow source: %s", id)
+			s.flush(src, entEnabled)
+			delete(s.sources, id)
+		}
+	}
+}
+
+// loadSnapshot reads the entity tables saved by a previous run, unless they are older than the max age.
+func (s *SysFlowReader) loadSnapshot(now time.Time) {
+	snap, err := cache.ReadSnapshot(s.config.SnapshotPath)
+	if os.IsNotExist(err) {
+		return
+	} else if err != nil {
+		logger.Warn.Printf("Unable to read entity tables snapshot %s: %v", s.config.SnapshotPath, err)
+		return
+	}
+	if age := now.Sub(snap.Time); s.config.SnapshotMaxAge > 0 && age > s.config.SnapshotMaxAge {
+		logger.Info.Printf("Ignoring entity tables snapshot %s taken %s ago", s.config.SnapshotPath, age.Round(time.Second))
+		return
+	}
+	s.restore = snap.Sources
+}
+
+// restoreSource fills the tables of a new source from the snapshot, if it holds the source's entities.
+func (s *SysFlowReader) restoreSource(id string, src *source) {
+	if e, ok := s.restore[id]; ok {
+		src.tables.Restore(e)
+		delete(s.restore, id)
+		logger.Info.Printf("Restored entity tables of source '%s': %s", id, src.tables.Stats())
+	}
+}
+
+// saveSnapshot writes the entity tables of all sources to the snapshot file.
+func (s *SysFlowReader) saveSnapshot(now time.Time) {
+	snap := &cache.Snapshot{Time: now, Sources: make(map[string]*cache.Entities)}
+	for id, src := range s.sources {
+		if src.hdr != nil {
+			snap.Sources[id] = src.tables.Entities()
+		}
+	}
+	if err := cache.WriteSnapshot(s.config.SnapshotPath, snap); err != nil {
+		logger.Error.Printf("Unable to write entity tables snapshot %s: %v", s.config.SnapshotPath, err)
+	}
+}
+
+// dumpTables writes the statistics and contents of the entity tables of all sources, keyed by
+// exporter ID, as JSON into a new file in the dump directory, and returns the file path.
+func (s *SysFlowReader) dumpTables(now time.Time) (string, error) {
+	dump := make(map[string]*sourceDump, len(s.sources))
+	for id, src := range s.sources {
+		dump[id] = src.dump()
+	}
+	path := filepath.Join(s.config.DumpDir, fmt.Sprintf("sftables-%d.json", now.UnixNano()))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(dump); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// process holds back a SysFlow record if the entities it refers to are not cached yet,
+// and otherwise handles it along with the held back records it resolves.
+func (s *SysFlowReader) process(r *sfgo.SysFlow, entEnabled bool) {
+	now := time.Now()
+	if r.Rec.UnionType == sfgo.SF_HEADER {
+		s.switchSource(r.Rec.SFHeader, now, entEnabled)
+	}
+	src := s.src
+	src.lastSeen = now
+	if src.holdback == nil {
+		s.handle(r, src.hdr, src.tables, entEnabled)
+		return
+	}
+	s.emit(src.holdback.expire(now), src.tables, entEnabled)
+	switch r.Rec.UnionType {
+	case sfgo.SF_HEADER:
+		// headers are never held back
+	case sfgo.SF_PROCESS:
+		s.handle(r, src.hdr, src.tables, entEnabled)
+		s.emit(src.holdback.release(procKey(*r.Rec.Process.Oid), src.tables), src.tables, entEnabled)
+		return
+	case sfgo.SF_FILE:
+		s.handle(r, src.hdr, src.tables, entEnabled)
+		s.emit(src.holdback.release(fileKey(r.Rec.File.Oid), src.tables), src.tables, entEnabled)
+		return
+	default:
+		if k, missing := missingEntity(r, src.tables); missing {
+			if p := src.holdback.park(r, src.hdr, k, now); p != nil {
+				s.emit([]*parked{p}, src.tables, entEnabled)
 			}
-		case sfgo.SF_FILE:
-			sf.File = sf.Rec.File
-			s.tables.SetFile(sf.File.Oid, sf.File)
-			if entEnabled {
-				sf.Container = s.getContFromFile(sf.File)
-				sf.Pod = s.getPodFromCont(sf.Container)
-				s.hdl.HandleFile(sf, sf.File)
+			return
+		}
+	}
+	s.handle(r, src.hdr, src.tables, entEnabled)
+}
+
+// flush handles all records held back for a source.
+func (s *SysFlowReader) flush(src *source, entEnabled bool) {
+	if src.holdback != nil {
+		s.emit(src.holdback.flush(), src.tables, entEnabled)
+	}
+}
+
+// emit handles held back records.
+func (s *SysFlowReader) emit(recs []*parked, tables *cache.SFTables, entEnabled bool) {
+	for _, p := range recs {
+		s.handle(p.rec, p.hdr, tables, entEnabled)
+	}
+}
+
+// handle resolves the entities of a SysFlow record from the tables of its source and passes it to the handler.
+func (s *SysFlowReader) handle(r *sfgo.SysFlow, hdr *sfgo.SFHeader, tables *cache.SFTables, entEnabled bool) {
+	sf := new(plugins.CtxSysFlow)
+	sf.SysFlow = r
+	sf.Header = hdr
+	switch sf.Rec.UnionType {
+	case sfgo.SF_HEADER:
+		if entEnabled {
+			s.hdl.HandleHeader(sf, hdr)
+		}
+	case sfgo.SF_CONT:
+		cont := sf.Rec.Container
+		tables.SetCont(cont.Id, cont)
+		if entEnabled {
+			sf.Container = cont
+			sf.Pod = s.getPodFromCont(tables, cont)
+			s.hdl.HandleContainer(sf, cont)
+		}
+	case sfgo.SF_POD:
+		pod := sf.Rec.Pod
+		tables.SetPod(pod.Id, pod)
+		if entEnabled {
+			sf.Pod = pod
+			s.hdl.HandlePod(sf, pod)
+		}
+	case sfgo.SF_K8S_EVT:
+		ke := sf.Rec.K8sEvent
+		s.hdl.HandleK8sEvt(sf, ke)
+		if ke.Kind == sfgo.K8sComponentK8S_PODS && ke.Action == sfgo.K8sActionK8S_COMPONENT_DELETED {
+			for _, id := range gjson.Get(ke.Message, "items.#.uid").Array() {
+				tables.DeletePod(id.String())
 			}
-		case sfgo.SF_PROC_EVT:
-			pe := sf.Rec.ProcessEvent
-			sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(pe.ProcOID)
-			s.hdl.HandleProcEvt(sf, pe)
-		case sfgo.SF_NET_FLOW:
-			nf := sf.Rec.NetworkFlow
-			sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(nf.ProcOID)
-			s.hdl.HandleNetFlow(sf, nf)
-		case sfgo.SF_FILE_FLOW:
-			ff := sf.Rec.FileFlow
-			sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(ff.ProcOID)
-			sf.File = s.getFile(ff.FileOID)
-			s.hdl.HandleFileFlow(sf, ff)
-		case sfgo.SF_FILE_EVT:
-			fe := sf.Rec.FileEvent
-			sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(fe.ProcOID)
-			sf.File = s.getFile(fe.FileOID)
-			sf.NewFile = s.getOptFile(fe.NewFileOID)
-			s.hdl.HandleFileEvt(sf, fe)
-		case sfgo.SF_PROC_FLOW:
-		case sfgo.SF_NET_EVT:
-		default:
-			logger.Warn.Printf("Error unsupported SysFlow Type: %d", sf.Rec.UnionType)
 		}
+	case sfgo.SF_PROCESS:
+		proc := sf.Rec.Process
+		proc.Exe = strings.TrimSpace(proc.Exe)
+		proc.ExeArgs = strings.TrimSpace(proc.ExeArgs)
+		tables.SetProc(*proc.Oid, proc)
+		if entEnabled {
+			sf.Process = proc
+			sf.PTree = tables.GetPtree(*proc.Oid)
+			sf.Container = s.getContFromProc(tables, proc)
+			sf.Pod = s.getPodFromCont(tables, sf.Container)
+			s.hdl.HandleProcess(sf, proc)
+		}
+	case sfgo.SF_FILE:
+		sf.File = sf.Rec.File
+		tables.SetFile(sf.File.Oid, sf.File)
+		if entEnabled {
+			sf.Container = s.getContFromFile(tables, sf.File)
+			sf.Pod = s.getPodFromCont(tables, sf.Container)
+			s.hdl.HandleFile(sf, sf.File)
+		}
+	case sfgo.SF_PROC_EVT:
+		pe := sf.Rec.ProcessEvent
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, pe.ProcOID)
+		s.hdl.HandleProcEvt(sf, pe)
+		if pe.OpFlags&sfgo.OP_EXIT == sfgo.OP_EXIT && pe.Tid == pe.ProcOID.Hpid {
+			tables.ExitProc(*pe.ProcOID)
+		}
+	case sfgo.SF_NET_FLOW:
+		nf := sf.Rec.NetworkFlow
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, nf.ProcOID)
+		s.hdl.HandleNetFlow(sf, nf)
+	case sfgo.SF_FILE_FLOW:
+		ff := sf.Rec.FileFlow
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, ff.ProcOID)
+		sf.File = s.getFile(tables, ff.FileOID)
+		s.hdl.HandleFileFlow(sf, ff)
+	case sfgo.SF_FILE_EVT:
+		fe := sf.Rec.FileEvent
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, fe.ProcOID)
+		sf.File = s.getFile(tables, fe.FileOID)
+		sf.NewFile = s.getOptFile(tables, fe.NewFileOID)
+		s.hdl.HandleFileEvt(sf, fe)
+	case sfgo.SF_PROC_FLOW:
+		pf := sf.Rec.ProcessFlow
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, pf.ProcOID)
+		s.hdl.HandleProcFlow(sf, pf)
+	case sfgo.SF_NET_EVT:
+		ne := sf.Rec.NetworkEvent
+		sf.Pod, sf.Container, sf.Process, sf.PTree = s.getPodContAndProc(tables, ne.ProcOID)
+		s.hdl.HandleNetEvt(sf, ne)
+	default:
+		logger.Warn.Printf("Error unsupported SysFlow Type: %d", sf.Rec.UnionType)
 	}
 }
 
@@ -171,9 +414,9 @@ func (s *SysFlowReader) Cleanup() {
 	s.hdl.Cleanup()
 }
 
-func (s *SysFlowReader) getContFromProc(proc *sfgo.Process) *sfgo.Container {
+func (s *SysFlowReader) getContFromProc(tables *cache.SFTables, proc *sfgo.Process) *sfgo.Container {
 	if proc.ContainerId != nil && proc.ContainerId.UnionType == sfgo.ContainerIdUnionTypeEnumString {
-		if c := s.tables.GetCont(proc.ContainerId.String); c != nil {
+		if c := tables.GetCont(proc.ContainerId.String); c != nil {
 			return c
 		}
 		logger.Warn.Println("No container object for ID: ", proc.ContainerId.String)
@@ -181,9 +424,9 @@ func (s *SysFlowReader) getContFromProc(proc *sfgo.Process) *sfgo.Container {
 	return nil
 }
 
-func (s *SysFlowReader) getPodFromCont(cont *sfgo.Container) *sfgo.Pod {
+func (s *SysFlowReader) getPodFromCont(tables *cache.SFTables, cont *sfgo.Container) *sfgo.Pod {
 	if cont != nil && cont.PodId != nil && cont.PodId.UnionType == sfgo.PodIdUnionTypeEnumString {
-		if pd := s.tables.GetPod(cont.PodId.String); pd != nil {
+		if pd := tables.GetPod(cont.PodId.String); pd != nil {
 			return pd
 		}
 		logger.Warn.Println("No pod object for ID: ", cont.PodId.String)
@@ -191,35 +434,35 @@ func (s *SysFlowReader) getPodFromCont(cont *sfgo.Container) *sfgo.Pod {
 	return nil
 }
 
-func (s *SysFlowReader) getPodContAndProc(oid *sfgo.OID) (*sfgo.Pod, *sfgo.Container, *sfgo.Process, []*sfgo.Process) {
-	if p := s.tables.GetProc(*oid); p != nil {
-		ptree := s.tables.GetPtree(*oid)
-		c     := s.getContFromProc(p)
-		pd    := s.getPodFromCont(c)
+func (s *SysFlowReader) getPodContAndProc(tables *cache.SFTables, oid *sfgo.OID) (*sfgo.Pod, *sfgo.Container, *sfgo.Process, []*sfgo.Process) {
+	if p := tables.GetProc(*oid); p != nil {
+		ptree := tables.GetPtree(*oid)
+		c     := s.getContFromProc(tables, p)
+		pd    := s.getPodFromCont(tables, c)
 		return pd, c, p, ptree
 	}
 	logger.Error.Println("No process object for ID: ", *oid)
 	return nil, nil, nil, nil
 }
 
-func (s *SysFlowReader) getFile(foid sfgo.FOID) *sfgo.File {
-	if f := s.tables.GetFile(foid); f != nil {
+func (s *SysFlowReader) getFile(tables *cache.SFTables, foid sfgo.FOID) *sfgo.File {
+	if f := tables.GetFile(foid); f != nil {
 		return f
 	}
 	logger.Error.Println("No file object for FOID: ", foid)
 	return nil
 }
 
-func (s *SysFlowReader) getOptFile(unf *sfgo.NewFileOIDUnion) *sfgo.File {
+func (s *SysFlowReader) getOptFile(tables *cache.SFTables, unf *sfgo.NewFileOIDUnion) *sfgo.File {
 	if unf != nil && unf.UnionType == sfgo.NewFileOIDUnionTypeEnumFOID {
-		return s.getFile(unf.FOID)
+		return s.getFile(tables, unf.FOID)
 	}
 	return nil
 }
 
-func (s *SysFlowReader) getContFromFile(file *sfgo.File) *sfgo.Container {
+func (s *SysFlowReader) getContFromFile(tables *cache.SFTables, file *sfgo.File) *sfgo.Container {
 	if file != nil && file.ContainerId.UnionType == sfgo.ContainerIdUnionTypeEnumString {
-		if c := s.tables.GetCont(file.ContainerId.String); c != nil {
+		if c := tables.GetCont(file.ContainerId.String); c != nil {
 			return c
 		}
 		logger.Warn.Println("Not container object for ID: ", file.ContainerId.String)
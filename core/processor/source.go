@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processor implements a processor plugin.
+package processor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/cache"
+)
+
+// source holds the header context and entity tables of a SysFlow exporter. Records carry no
+// exporter ID of their own, so a merged stream switches between sources through SF_HEADER records.
+type source struct {
+	hdr      *sfgo.SFHeader
+	tables   *cache.SFTables
+	holdback *holdback
+	lastSeen time.Time
+}
+
+func newSource(conf ReaderConfig, now time.Time) *source {
+	src := &source{tables: cache.NewSFTablesFromConfig(conf.Tables), lastSeen: now}
+	if conf.HoldbackSize > 0 {
+		src.holdback = newHoldback(conf.HoldbackSize, conf.HoldbackTimeout)
+	}
+	return src
+}
+
+// sourceDump holds the dump of a source's entity tables and its hold-back counters.
+type sourceDump struct {
+	*cache.TablesDump
+	Holdback *HoldbackStats `json:"holdback,omitempty"`
+}
+
+// dump returns the current contents and statistics of the source's tables and hold-back buffer.
+func (src *source) dump() *sourceDump {
+	d := &sourceDump{TablesDump: src.tables.Dump()}
+	if src.holdback != nil {
+		stats := src.holdback.Stats()
+		d.Holdback = &stats
+	}
+	return d
+}
+
+// String returns a one-line summary of the source's tables and hold-back counters.
+func (src *source) String() string {
+	if src.holdback != nil {
+		return fmt.Sprintf("%s; held back records: %s", src.tables.Stats(), src.holdback)
+	}
+	return src.tables.Stats().String()
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2020 IBM Corporation.
+//
+// Authors:
+// Frederico Araujo <frederico.araujo@ibm.com>
+// Teryl Taylor <terylt@ibm.com>
+// Andreas Schade <san@zurich.ibm.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package processor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+)
+
+func header(exporter string, filename string) *sfgo.SysFlow {
+	return &sfgo.SysFlow{Rec: &sfgo.RecUnion{UnionType: sfgo.SF_HEADER, SFHeader: &sfgo.SFHeader{Exporter: exporter, Filename: filename}}}
+}
+
+func process(pid int64) *sfgo.SysFlow {
+	return &sfgo.SysFlow{Rec: &sfgo.RecUnion{UnionType: sfgo.SF_PROCESS, Process: &sfgo.Process{Oid: &sfgo.OID{Hpid: pid}}}}
+}
+
+func TestSources(t *testing.T) {
+	s := &SysFlowReader{config: ReaderConfig{SourceTTL: time.Hour}}
+	s.src = newSource(s.config, time.Now())
+	s.sources = map[string]*source{"": s.src}
+	hasProc := func(exporter string, pid int64) bool {
+		return s.sources[exporter].tables.GetProc(sfgo.OID{Hpid: pid}) != nil
+	}
+
+	// interleaved streams of two exporters
+	s.process(header("node1", ""), false)
+	s.process(process(1), false)
+	s.process(header("node2", ""), false)
+	s.process(process(2), false)
+	s.process(header("node1", ""), false)
+	s.process(process(3), false)
+	assert.Len(t, s.sources, 2)
+	assert.True(t, hasProc("node1", 1))
+	assert.True(t, hasProc("node1", 3))
+	assert.False(t, hasProc("node1", 2))
+	assert.True(t, hasProc("node2", 2))
+	assert.Equal(t, "node1", s.src.hdr.Exporter)
+
+	// a new trace file resets the tables of its exporter only
+	s.process(header("node2", "trace.2"), false)
+	assert.False(t, hasProc("node2", 2))
+	assert.True(t, hasProc("node1", 1))
+
+	// idle sources expire
+	s.expireSources(time.Now().Add(2*time.Hour), false)
+	assert.Len(t, s.sources, 1)
+	assert.Contains(t, s.sources, "node2")
+}
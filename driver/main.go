@@ -29,9 +29,11 @@ import (
 	"runtime/trace"
 	"syscall"
 
+	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
 	"github.com/sysflow-telemetry/sf-apis/go/sfgo"
+	"github.com/sysflow-telemetry/sf-processor/core/policyengine/engine"
 	"github.com/sysflow-telemetry/sf-processor/driver/manifest"
 	"github.com/sysflow-telemetry/sf-processor/driver/pipeline"
 )
@@ -66,13 +68,18 @@ func run() int {
 	logLevel := flag.String("log", "info", "Log level {trace|info|warn|error|health|quiet}")
 	driverDir := flag.String("driverdir", pipeline.DriverDir, "Dynamic driver directory")
 	pluginDir := flag.String("plugdir", pipeline.PluginDir, "Dynamic plugins directory")
+	coverage := flag.String("coverage", "", "Write policy coverage report to `file`")
+	depgraph := flag.String("depgraph", "", "Output the dependency graph of the policies in path {dot|json}")
+	affected := flag.String("affected", "", "Output the rules in path affected by editing the macro or list `name`")
 	test := flag.Bool("test", false, "Test pipeline configuration")
 	version := flag.Bool("version", false, "Output version information")
 
 	flag.Usage = func() {
 		fmt.Println(`Usage: sfprocessor [-version
 		   |-test [-log <value>] [-config <value>] [-driverdir <value>] [-plugdir <value>]]
-		   |[-driver <value>] [-log <value>] [-config <value>] [-driverdir <value>] [-plugdir <value>] [-cpuprofile <value>] [-memprofile <value>] [-traceprofile <value>] path]`)
+		   |-depgraph <value> [-log <value>] path
+		   |-affected <value> [-log <value>] path
+		   |[-driver <value>] [-log <value>] [-config <value>] [-driverdir <value>] [-plugdir <value>] [-cpuprofile <value>] [-memprofile <value>] [-traceprofile <value>] [-coverage <value>] path]`)
 		fmt.Println()
 		fmt.Println("Positional arguments:")
 		fmt.Println("  path string\n\tInput path")
@@ -101,6 +108,11 @@ func run() int {
 	// initialize logger
 	logger.InitLoggers(logger.GetLogLevelFromValue(*logLevel))
 
+	// outputs the policy dependency graph and exits
+	if *depgraph != "" || *affected != "" {
+		return writeDepGraph(flag.Arg(0), *depgraph, *affected)
+	}
+
 	// CPU profiling
 	if *cpuprofile != "" {
 		f, err := os.Create(*cpuprofile)
@@ -133,7 +145,11 @@ func run() int {
 	}
 
 	// load pipeline
-	pl = pipeline.New(*driverDir, *pluginDir, *configFile)
+	p := pipeline.New(*driverDir, *pluginDir, *configFile)
+	if *coverage != "" {
+		p.AddConfigItem(engine.CoverageKey, *coverage)
+	}
+	pl = p
 	err := pl.Load(*inputType)
 	if err != nil {
 		logger.Error.Println("Unable to load pipeline error: ", err.Error())
@@ -177,3 +193,45 @@ func run() int {
 	}
 	return 0
 }
+
+// writeDepGraph compiles the policies in path and writes their dependency graph in format
+// to stdout, or the rules affected by editing macro or list affected if set.
+func writeDepGraph(path string, format string, affected string) int {
+	paths, err := ioutils.ListFilePaths(path, ".yaml")
+	if err != nil {
+		logger.Error.Println("Unable to list policy files: ", err)
+		return 1
+	}
+	pi := engine.NewPolicyInterpreter(engine.Config{}, nil)
+	if err := pi.Compile(paths...); err != nil {
+		logger.Error.Println("Unable to compile policies: ", err)
+		return 1
+	}
+	g := pi.DependencyGraph()
+	if affected != "" {
+		if _, ok := g.Macros[affected]; !ok {
+			if _, ok := g.Lists[affected]; !ok {
+				logger.Error.Printf("No macro or list named %s found in policies", affected)
+				return 1
+			}
+		}
+		for _, r := range g.Affected(affected) {
+			fmt.Println(r)
+		}
+		return 0
+	}
+	switch format {
+	case "dot":
+		err = g.WriteDOT(os.Stdout)
+	case "json":
+		err = g.WriteJSON(os.Stdout)
+	default:
+		logger.Error.Printf("Unsupported dependency graph format %s", format)
+		return 1
+	}
+	if err != nil {
+		logger.Error.Println("Unable to write dependency graph: ", err)
+		return 1
+	}
+	return 0
+}
@@ -42,6 +42,7 @@ type Pipeline struct {
 	pluginDir   string
 	driverDir   string
 	running     bool
+	confItems   map[string]string
 }
 
 // New creates a new pipeline object
@@ -51,9 +52,15 @@ func New(driverDir string, pluginDir string, config string) *Pipeline {
 		pluginDir:   pluginDir,
 		wg:          new(sync.WaitGroup),
 		pluginCache: NewPluginCache(config),
+		confItems:   make(map[string]string),
 	}
 }
 
+// AddConfigItem sets a config item on all processors in the pipeline when it is loaded.
+func (pl *Pipeline) AddConfigItem(k string, v string) {
+	pl.confItems[k] = v
+}
+
 // GetNumChannels returns the number of channels in the pipeline
 func (pl *Pipeline) GetNumChannels() int {
 	return len(pl.channels)
@@ -87,6 +94,9 @@ func (pl *Pipeline) Load(driverName string) error {
 		return err
 	}
 	setManifestInfo(conf)
+	for k, v := range pl.confItems {
+		addGlobalConfigItem(conf, k, v)
+	}
 	if err := pl.pluginCache.LoadDrivers(pl.driverDir); err != nil {
 		logger.Error.Println("Unable to load dynamic driver: ", err)
 		return err
@@ -32,6 +32,7 @@ import (
 	"github.com/sysflow-telemetry/sf-apis/go/ioutils"
 	"github.com/sysflow-telemetry/sf-apis/go/logger"
 	"github.com/sysflow-telemetry/sf-apis/go/plugins"
+	"github.com/sysflow-telemetry/sf-processor/core/aggregator"
 	"github.com/sysflow-telemetry/sf-processor/core/exporter"
 	"github.com/sysflow-telemetry/sf-processor/core/policyengine"
 	"github.com/sysflow-telemetry/sf-processor/core/processor"
@@ -66,6 +67,7 @@ func NewPluginCache(conf string) *PluginCache {
 func (p *PluginCache) init() {
 	(&processor.SysFlowReader{}).Register(p)
 	(&processor.SysFlowProcessor{}).Register(p)
+	(&aggregator.Aggregator{}).Register(p)
 	(&policyengine.PolicyEngine{}).Register(p)
 	(&exporter.Exporter{}).Register(p)
 	(&sysflow.FileDriver{}).Register(p)